@@ -0,0 +1,220 @@
+/*
+Copyright © 2025 Denys Makeienko <denys.makeienko@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/spf13/cobra"
+)
+
+// aliasRegexPrefix separates the keyword from the regex in alias values, e.g. "latest:^1\.8"
+const aliasRegexPrefix = latestArg + ":"
+
+var aliasNameRegex = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_-]*$`)
+
+// readAliases returns all aliases stored in the aliases file
+func readAliases() (map[string]string, error) {
+	aliases := make(map[string]string)
+	data, err := os.ReadFile(aliasesFilePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return aliases, nil
+		}
+		return aliases, fmt.Errorf("failed to read %s: %w", aliasesFilePath, err)
+	}
+	if err := json.Unmarshal(data, &aliases); err != nil {
+		return aliases, fmt.Errorf("failed to parse %s: %w", aliasesFilePath, err)
+	}
+	return aliases, nil
+}
+
+func writeAliases(aliases map[string]string) error {
+	data, err := json.MarshalIndent(aliases, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal aliases: %w", err)
+	}
+	if err := os.MkdirAll(rootURL, 0o750); err != nil {
+		return fmt.Errorf("failed to create %s: %w", rootURL, err)
+	}
+	if err := os.WriteFile(aliasesFilePath, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("failed to write %s: %w", aliasesFilePath, err)
+	}
+	return nil
+}
+
+// parseAliasValue splits an alias value into a version (or keyword) and an optional regex
+func parseAliasValue(value string) (string, *regexp.Regexp, error) {
+	if strings.HasPrefix(value, aliasRegexPrefix) {
+		versionRegex, err := regexp.Compile(strings.TrimSpace(strings.TrimPrefix(value, aliasRegexPrefix)))
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid regex in alias value %q: %w", value, err)
+		}
+		return latestArg, versionRegex, nil
+	}
+
+	switch value {
+	case latestArg, latestAllowedArg, minRequiredArg:
+		return value, nil, nil
+	}
+	if _, err := semver.NewVersion(value); err != nil {
+		return "", nil, fmt.Errorf("invalid alias value %q: must be a version, a keyword or %s\"regex\"", value, aliasRegexPrefix)
+	}
+	return value, nil, nil
+}
+
+// expandAlias returns the version and regex an alias points to. isAlias is false if name is not an alias.
+func expandAlias(name string) (version string, versionRegex *regexp.Regexp, isAlias bool, err error) {
+	aliases, err := readAliases()
+	if err != nil {
+		return "", nil, false, err
+	}
+	value, ok := aliases[name]
+	if !ok {
+		return name, nil, false, nil
+	}
+	version, versionRegex, err = parseAliasValue(value)
+	if err != nil {
+		return "", nil, true, err
+	}
+	return version, versionRegex, true, nil
+}
+
+// getAliasesReferencing returns names of aliases pointing to the exact version
+func getAliasesReferencing(version string) []string {
+	aliases, err := readAliases()
+	if err != nil {
+		LogWarn("Failed to read aliases: %v", err)
+		return nil
+	}
+	var names []string
+	for name, value := range aliases {
+		if value == version {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// aliasCmd represents the alias command
+var aliasCmd = &cobra.Command{
+	Use:   "alias",
+	Short: "Manage named version aliases",
+}
+
+var aliasSetCmd = &cobra.Command{
+	Use:   "set <name> <version>",
+	Short: "Create or update an alias",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		name, value := args[0], args[1]
+		if !aliasNameRegex.MatchString(name) {
+			LogError("Invalid alias name %q: must start with a letter and contain only letters, digits, '-' and '_'", name)
+			return
+		}
+		switch name {
+		case latestArg, latestAllowedArg, minRequiredArg:
+			LogError("Alias name %q is a reserved keyword", name)
+			return
+		}
+		if _, _, err := parseAliasValue(value); err != nil {
+			LogError("%v", err)
+			return
+		}
+
+		aliases, err := readAliases()
+		if err != nil {
+			LogError("%v", err)
+			return
+		}
+		aliases[name] = value
+		if err := writeAliases(aliases); err != nil {
+			LogError("%v", err)
+			return
+		}
+		LogInfo("Alias %s set to %s", name, value)
+	},
+}
+
+var aliasListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List all aliases",
+	Args:    cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		aliases, err := readAliases()
+		if err != nil {
+			LogError("%v", err)
+			return
+		}
+		names := make([]string, 0, len(aliases))
+		for name := range aliases {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+		for _, name := range names {
+			fmt.Fprintf(w, "%s\t%s\n", name, aliases[name])
+		}
+		_ = w.Flush()
+	},
+}
+
+var aliasRmCmd = &cobra.Command{
+	Use:     "rm <name>",
+	Aliases: []string{"remove"},
+	Short:   "Remove an alias",
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		aliases, err := readAliases()
+		if err != nil {
+			LogError("%v", err)
+			return
+		}
+		if _, ok := aliases[args[0]]; !ok {
+			LogError("Alias %s does not exist", args[0])
+			return
+		}
+		delete(aliases, args[0])
+		if err := writeAliases(aliases); err != nil {
+			LogError("%v", err)
+			return
+		}
+		LogInfo("Alias %s removed", args[0])
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(aliasCmd)
+	aliasCmd.AddCommand(aliasSetCmd)
+	aliasCmd.AddCommand(aliasListCmd)
+	aliasCmd.AddCommand(aliasRmCmd)
+}
@@ -0,0 +1,146 @@
+/*
+Copyright © 2025 Denys Makeienko <denys.makeienko@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const defaultRemoteCacheTTL = time.Hour
+
+// flags
+var refreshRemoteCache bool
+
+// remoteVersionCache is the on-disk cache of the remote version list
+type remoteVersionCache struct {
+	FetchedAt    time.Time `json:"fetched_at"`
+	Mirror       string    `json:"mirror,omitempty"`
+	ETag         string    `json:"etag,omitempty"`
+	LastModified string    `json:"last_modified,omitempty"`
+	Versions     []string  `json:"versions"`
+}
+
+// getRemoteCacheTTL returns TTL of the remote version list cache, 0 disables the cache
+func getRemoteCacheTTL() time.Duration {
+	value := getEnv(remoteCacheTTLEnvKey, "")
+	if value == "" {
+		return defaultRemoteCacheTTL
+	}
+	ttl, err := time.ParseDuration(value)
+	if err != nil {
+		LogWarn("Invalid %s value %q, using default %s", remoteCacheTTLEnvKey, value, defaultRemoteCacheTTL)
+		return defaultRemoteCacheTTL
+	}
+	return ttl
+}
+
+func readRemoteVersionCache() (*remoteVersionCache, error) {
+	data, err := os.ReadFile(remoteCacheFilePath)
+	if err != nil {
+		return nil, err
+	}
+	var cache remoteVersionCache
+	if err := json.Unmarshal(data, &cache); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", remoteCacheFilePath, err)
+	}
+	return &cache, nil
+}
+
+func writeRemoteVersionCache(cache *remoteVersionCache) error {
+	data, err := json.Marshal(cache)
+	if err != nil {
+		return fmt.Errorf("failed to marshal cache: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(remoteCacheFilePath), 0o750); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+	// Write to a temp file and rename so concurrent readers never see a partial file
+	tmpFile, err := os.CreateTemp(filepath.Dir(remoteCacheFilePath), ".remote-versions-*.json")
+	if err != nil {
+		return fmt.Errorf("failed to create cache file: %w", err)
+	}
+	if _, err := tmpFile.Write(data); err != nil {
+		_ = tmpFile.Close()
+		_ = os.Remove(tmpFile.Name())
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+	if err := tmpFile.Close(); err != nil {
+		_ = os.Remove(tmpFile.Name())
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+	if err := os.Rename(tmpFile.Name(), remoteCacheFilePath); err != nil {
+		_ = os.Remove(tmpFile.Name())
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+	return nil
+}
+
+// getRemoteVersionIndex returns all remote versions including prereleases, using the on-disk cache
+// when it's fresh, revalidating it when it's expired and falling back to it when the network is unavailable.
+func getRemoteVersionIndex() ([]string, error) {
+	ttl := getRemoteCacheTTL()
+	cache, err := readRemoteVersionCache()
+	if err != nil && !os.IsNotExist(err) {
+		LogDebug("Ignoring remote version cache: %v", err)
+	}
+	mirror := getMirrorURL()
+	if cache != nil && cache.Mirror != "" && cache.Mirror != mirror {
+		LogDebug("Ignoring remote version cache of %s", cache.Mirror)
+		cache = nil
+	}
+
+	if cache != nil && !refreshRemoteCache && ttl > 0 && time.Since(cache.FetchedAt) < ttl {
+		LogDebug("Using cached remote versions from %s", cache.FetchedAt.Format(time.RFC3339))
+		return cache.Versions, nil
+	}
+
+	var revalidate *remoteVersionCache
+	if !refreshRemoteCache {
+		revalidate = cache
+	}
+	fetched, err := fetchRemoteTerraformVersions(revalidate)
+	if err != nil {
+		if cache == nil {
+			return nil, err
+		}
+		LogWarn("%v", err)
+		LogWarn("Using stale cached remote versions from %s", cache.FetchedAt.Format(time.RFC3339))
+		return cache.Versions, nil
+	}
+
+	if fetched == nil {
+		// Not modified
+		fetched = cache
+	}
+	fetched.FetchedAt = time.Now().UTC()
+	fetched.Mirror = mirror
+	if ttl > 0 {
+		if err := writeRemoteVersionCache(fetched); err != nil {
+			LogWarn("Failed to cache remote versions: %v", err)
+		}
+	}
+	return fetched.Versions, nil
+}
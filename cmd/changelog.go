@@ -0,0 +1,266 @@
+/*
+Copyright © 2025 Denys Makeienko <denys.makeienko@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/spf13/cobra"
+)
+
+// Default changelog source. {minor} is replaced with the minor version, e.g. 1.5, as each
+// Terraform release branch keeps the changelog of its minor version only.
+const defaultChangelogSource = "https://raw.githubusercontent.com/hashicorp/terraform/v{minor}/CHANGELOG.md"
+
+const maxChangelogSize = 10 * 1024 * 1024
+
+var (
+	changelogSource   string
+	changelogMarkdown bool
+)
+
+var (
+	changelogHeadingRegex   = regexp.MustCompile(`^##\s+v?(\d+\.\d+\.\d+(?:-[0-9A-Za-z.]+)?)\b`)
+	changelogHighlightRegex = regexp.MustCompile(`(?i)(BREAKING CHANGES|UPGRADE NOTES)`)
+)
+
+// changelogSection is the changelog of a single version
+type changelogSection struct {
+	Version *semver.Version
+	Lines   []string
+}
+
+func (s changelogSection) hasHighlights() bool {
+	for _, line := range s.Lines {
+		if changelogHighlightRegex.MatchString(line) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseChangelog splits the changelog into version sections
+func parseChangelog(changelog string) []changelogSection {
+	var sections []changelogSection
+	var current *changelogSection
+	for _, line := range strings.Split(changelog, "\n") {
+		line = strings.TrimRight(line, "\r")
+		if strings.HasPrefix(line, "## ") {
+			if current != nil {
+				sections = append(sections, *current)
+				current = nil
+			}
+			if matches := changelogHeadingRegex.FindStringSubmatch(line); matches != nil {
+				if v, err := semver.NewVersion(matches[1]); err == nil {
+					current = &changelogSection{Version: v}
+				}
+			}
+		}
+		if current != nil {
+			current.Lines = append(current.Lines, line)
+		}
+	}
+	if current != nil {
+		sections = append(sections, *current)
+	}
+	return sections
+}
+
+// getChangelogMinors returns minor versions from the minor of from up to to, ascending, taken from the list
+// of released versions, so the number of fetched changelogs is bounded by the releases
+func getChangelogMinors(from, to *semver.Version, versions []string) []string {
+	fromMinor, _ := semver.NewVersion(fmt.Sprintf("%d.%d.0", from.Major(), from.Minor()))
+	toMinor, _ := semver.NewVersion(fmt.Sprintf("%d.%d.0", to.Major(), to.Minor()))
+	minorVersions := []*semver.Version{fromMinor, toMinor}
+	for _, version := range versions {
+		v, err := semver.NewVersion(version)
+		if err != nil {
+			continue
+		}
+		minor, _ := semver.NewVersion(fmt.Sprintf("%d.%d.0", v.Major(), v.Minor()))
+		if minor.GreaterThan(fromMinor) && minor.LessThan(toMinor) {
+			minorVersions = append(minorVersions, minor)
+		}
+	}
+	sort.Sort(semver.Collection(minorVersions))
+
+	var minors []string
+	for _, v := range minorVersions {
+		minor := fmt.Sprintf("%d.%d", v.Major(), v.Minor())
+		if !containsString(minors, minor) {
+			minors = append(minors, minor)
+		}
+	}
+	return minors
+}
+
+// getChangelogSections returns sections of versions greater than from and up to to, newest first.
+// A source with {minor} is read once per minor version in minors.
+func getChangelogSections(source string, from, to *semver.Version, minors []string) ([]changelogSection, error) {
+	seen := make(map[string]bool)
+	var sections []changelogSection
+	addSections := func(changelog string) {
+		for _, section := range parseChangelog(changelog) {
+			if !section.Version.GreaterThan(from) || section.Version.GreaterThan(to) || seen[section.Version.String()] {
+				continue
+			}
+			seen[section.Version.String()] = true
+			sections = append(sections, section)
+		}
+	}
+
+	if !strings.Contains(source, "{minor}") {
+		LogInfo("Reading changelog from %s", source)
+		changelog, err := readSource(source, maxChangelogSize)
+		if err != nil {
+			return nil, err
+		}
+		addSections(changelog)
+	} else {
+		for _, minor := range minors {
+			src := strings.ReplaceAll(source, "{minor}", minor)
+			LogInfo("Reading changelog from %s", src)
+			changelog, err := readSource(src, maxChangelogSize)
+			if err != nil {
+				return nil, err
+			}
+			addSections(changelog)
+		}
+	}
+
+	sort.Slice(sections, func(i, j int) bool {
+		return sections[i].Version.GreaterThan(sections[j].Version)
+	})
+	return sections, nil
+}
+
+func printChangelogText(sections []changelogSection) {
+	for _, section := range sections {
+		for _, line := range section.Lines {
+			switch {
+			case changelogHeadingRegex.MatchString(line):
+				fmt.Println(Green + line + Reset)
+			case changelogHighlightRegex.MatchString(line):
+				fmt.Println(Red + line + Reset)
+			default:
+				fmt.Println(line)
+			}
+		}
+	}
+}
+
+func printChangelogMarkdown(sections []changelogSection, from, to string) {
+	fmt.Printf("# Terraform changelog %s → %s\n\n", from, to)
+
+	var highlighted []string
+	for _, section := range sections {
+		if section.hasHighlights() {
+			highlighted = append(highlighted, "`"+section.Version.String()+"`")
+		}
+	}
+	if len(highlighted) > 0 {
+		fmt.Printf("> [!WARNING]\n> Breaking changes or upgrade notes in: %s\n\n", strings.Join(highlighted, ", "))
+	}
+
+	for _, section := range sections {
+		for _, line := range section.Lines {
+			if changelogHighlightRegex.MatchString(line) && !strings.HasPrefix(line, "#") {
+				line = "**" + strings.TrimSpace(line) + "**"
+			}
+			fmt.Println(line)
+		}
+	}
+}
+
+// changelogCmd represents the changelog command
+var changelogCmd = &cobra.Command{
+	Use:   "changelog <from> <to>",
+	Short: "Show Terraform changelog between two versions",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		if changelogMarkdown {
+			SetLogOutput(os.Stderr)
+		}
+
+		var versions [2]*semver.Version
+		for i, arg := range args {
+			version, err := resolveVersion(arg, nil, "remote")
+			if err != nil {
+				FatalError("%v", err)
+			}
+			v, err := semver.NewVersion(version)
+			if err != nil {
+				FatalError("Invalid version %s: %v", arg, err)
+			}
+			versions[i] = v
+		}
+		from, to := versions[0], versions[1]
+		if !to.GreaterThan(from) {
+			FatalError("Target version %s must be greater than %s", to, from)
+		}
+
+		source := changelogSource
+		if source == "" {
+			source = getEnv(changelogSourceEnvKey, defaultChangelogSource)
+		}
+
+		var minors []string
+		if strings.Contains(source, "{minor}") {
+			versions, err := getRemoteTerraformVersions(true)
+			if err != nil {
+				FatalError("Failed to get remote versions: %v", err)
+			}
+			minors = getChangelogMinors(from, to, versions)
+		}
+
+		sections, err := getChangelogSections(source, from, to, minors)
+		if err != nil {
+			FatalError("Failed to get changelog: %v", err)
+		}
+		if len(sections) == 0 {
+			FatalError("No changelog entries found between %s and %s", from, to)
+		}
+
+		if changelogMarkdown {
+			printChangelogMarkdown(sections, from.String(), to.String())
+			return
+		}
+		printChangelogText(sections)
+
+		for _, section := range sections {
+			if section.hasHighlights() {
+				LogWarn("v%s has breaking changes or upgrade notes", section.Version)
+			}
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(changelogCmd)
+	changelogCmd.Flags().StringVarP(&changelogSource, "source", "s", "", "Changelog URL or local file path, {minor} is replaced with the minor version")
+	changelogCmd.Flags().BoolVarP(&changelogMarkdown, "markdown", "m", false, "Output markdown for pasting into pull requests")
+}
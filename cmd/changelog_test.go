@@ -0,0 +1,145 @@
+/*
+Copyright © 2025 Denys Makeienko <denys.makeienko@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+func sectionVersions(sections []changelogSection) []string {
+	versions := []string{}
+	for _, section := range sections {
+		versions = append(versions, section.Version.String())
+	}
+	return versions
+}
+
+func TestParseChangelog(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "changelog", "CHANGELOG.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sections := parseChangelog(string(data))
+
+	tests := []struct {
+		version        string
+		firstLine      string
+		lines          int
+		wantHighlights bool
+	}{
+		{version: "1.6.1", firstLine: "## 1.6.1 (October 10, 2023)", lines: 6},
+		// Sections end at any level 2 heading, including ones without a version
+		{version: "1.6.0", firstLine: "## 1.6.0 (October 4, 2023)", lines: 10, wantHighlights: true},
+		{version: "1.5.7", firstLine: "## 1.5.7 (September 7, 2023)", lines: 6},
+		{version: "1.5.6", firstLine: "## 1.5.6 (August 23, 2023)", lines: 4},
+	}
+	if len(sections) != len(tests) {
+		t.Fatalf("got sections %v, want %d", sectionVersions(sections), len(tests))
+	}
+	for i, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			section := sections[i]
+			if section.Version.String() != tt.version {
+				t.Fatalf("got version %s, want %s", section.Version, tt.version)
+			}
+			if section.Lines[0] != tt.firstLine {
+				t.Errorf("got first line %q, want %q", section.Lines[0], tt.firstLine)
+			}
+			if len(section.Lines) != tt.lines {
+				t.Errorf("got %d lines, want %d: %q", len(section.Lines), tt.lines, section.Lines)
+			}
+			if section.hasHighlights() != tt.wantHighlights {
+				t.Errorf("got highlights %t, want %t", section.hasHighlights(), tt.wantHighlights)
+			}
+		})
+	}
+}
+
+func TestGetChangelogSections(t *testing.T) {
+	fixture := filepath.Join("testdata", "changelog", "CHANGELOG.md")
+	perMinor := filepath.Join("testdata", "changelog", "v{minor}.md")
+
+	tests := []struct {
+		name    string
+		source  string
+		from    string
+		to      string
+		minors  []string
+		want    []string
+		wantErr bool
+	}{
+		{name: "from is excluded, to is included", source: fixture, from: "1.5.6", to: "1.6.0", want: []string{"1.6.0", "1.5.7"}},
+		{name: "whole file", source: fixture, from: "1.5.0", to: "1.7.0", want: []string{"1.6.1", "1.6.0", "1.5.7", "1.5.6"}},
+		{name: "single patch", source: fixture, from: "1.6.0", to: "1.6.1", want: []string{"1.6.1"}},
+		{name: "no entries", source: fixture, from: "1.6.1", to: "1.6.5", want: []string{}},
+		{name: "per minor, duplicates are skipped", source: perMinor, from: "1.5.6", to: "1.6.0", minors: []string{"1.5", "1.6"}, want: []string{"1.6.0", "1.5.7"}},
+		{name: "per minor across majors", source: perMinor, from: "1.5.7", to: "2.0.0", minors: []string{"1.5", "1.6", "2.0"}, want: []string{"2.0.0", "1.6.0"}},
+		{name: "missing minor changelog", source: perMinor, from: "1.6.0", to: "1.7.0", minors: []string{"1.6", "1.7"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, to := semver.MustParse(tt.from), semver.MustParse(tt.to)
+			sections, err := getChangelogSections(tt.source, from, to, tt.minors)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("getChangelogSections: %v", err)
+			}
+			if got := sectionVersions(sections); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetChangelogMinors(t *testing.T) {
+	versions := []string{"2.1.0", "2.0.0", "1.7.0-alpha1", "1.6.1", "1.6.0", "1.5.7", "1.4.0", "invalid"}
+
+	tests := []struct {
+		name string
+		from string
+		to   string
+		want []string
+	}{
+		{name: "same minor", from: "1.5.0", to: "1.5.7", want: []string{"1.5"}},
+		{name: "released minors only", from: "1.4.0", to: "1.6.1", want: []string{"1.4", "1.5", "1.6"}},
+		{name: "bounded across majors", from: "1.5.7", to: "2.0.0", want: []string{"1.5", "1.6", "1.7", "2.0"}},
+		{name: "minors of from and to are always included", from: "0.15.0", to: "3.0.0", want: []string{"0.15", "1.4", "1.5", "1.6", "1.7", "2.0", "2.1", "3.0"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := getChangelogMinors(semver.MustParse(tt.from), semver.MustParse(tt.to), versions)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -20,19 +21,23 @@ func getEnv(envVar, defaultValue string) string {
 }
 
 func getTerraformVersionConstraint() (string, error) {
-	// Define regex pattern to match required_version
-	requiredVersionPattern := regexp.MustCompile(`required_version\s*=\s*"([^"]+)"`)
-
 	// Get current directory
 	cwd, err := os.Getwd()
 	if err != nil {
 		return "", fmt.Errorf("error getting current directory: %w", err)
 	}
+	return getTerraformVersionConstraintIn(cwd)
+}
 
-	// Read all files in the current directory
+// getTerraformVersionConstraintIn returns required_version from .tf files in the directory
+func getTerraformVersionConstraintIn(cwd string) (string, error) {
+	// Define regex pattern to match required_version
+	requiredVersionPattern := regexp.MustCompile(`required_version\s*=\s*"([^"]+)"`)
+
+	// Read all files in the directory
 	entries, err := os.ReadDir(cwd)
 	if err != nil {
-		return "", fmt.Errorf("error reading current directory: %w", err)
+		return "", fmt.Errorf("error reading directory: %w", err)
 	}
 
 	var requiredVersion string
@@ -139,9 +144,20 @@ func getLatestAllowed(target, constraint string) (string, error) {
 		terraformVersionContraint = constraint
 	}
 	LogInfo("Found version constraint: %s", terraformVersionContraint)
-	constraints, err := semver.NewConstraint(terraformVersionContraint)
+
+	validVersions, err := getAllowedVersions(target, terraformVersionContraint)
 	if err != nil {
-		return "", fmt.Errorf("invalid constraint: %w", err)
+		return "", err
+	}
+
+	return validVersions[0].String(), nil // Return the highest matching version
+}
+
+// getAllowedVersions returns all local or remote versions satisfying the constraint in descending order
+func getAllowedVersions(target, constraint string) ([]*semver.Version, error) {
+	constraints, err := semver.NewConstraint(constraint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid constraint: %w", err)
 	}
 
 	var terraformVersions []string
@@ -153,8 +169,13 @@ func getLatestAllowed(target, constraint string) (string, error) {
 		terraformVersions, _ = getRemoteTerraformVersions(false)
 	}
 
+	return filterAllowedVersions(terraformVersions, constraints)
+}
+
+// filterAllowedVersions returns versions satisfying the constraints in descending order
+func filterAllowedVersions(terraformVersions []string, constraints *semver.Constraints) ([]*semver.Version, error) {
 	if len(terraformVersions) == 0 {
-		return "", fmt.Errorf("no terraform versions found")
+		return nil, fmt.Errorf("no terraform versions found")
 	}
 
 	var validVersions []*semver.Version
@@ -169,12 +190,12 @@ func getLatestAllowed(target, constraint string) (string, error) {
 	}
 
 	if len(validVersions) == 0 {
-		return "", fmt.Errorf("no available versions satisfy the constraint")
+		return nil, fmt.Errorf("no available versions satisfy the constraint")
 	}
 
 	sort.Sort(sort.Reverse(semver.Collection(validVersions))) // Even though getRemoteTerraformVersions() returns versions in desc order, sort it to ensure it
 
-	return validVersions[0].String(), nil // Return the highest matching version
+	return validVersions, nil
 }
 
 func validateArg(arg string, allowedVersions map[string]bool) error {
@@ -182,12 +203,24 @@ func validateArg(arg string, allowedVersions map[string]bool) error {
 		return nil
 	}
 
+	// Aliases are allowed anywhere a version is accepted
+	aliases, err := readAliases()
+	if err != nil {
+		LogWarn("Failed to read aliases: %v", err)
+	}
+	if _, ok := aliases[arg]; ok {
+		return nil
+	}
+
 	// Check if it's a valid Semver version
 	if _, err := semver.NewVersion(arg); err != nil {
-		validArgs := make([]string, 0, len(allowedVersions))
+		validArgs := make([]string, 0, len(allowedVersions)+len(aliases))
 		for k := range allowedVersions {
 			validArgs = append(validArgs, k)
 		}
+		for k := range aliases {
+			validArgs = append(validArgs, k)
+		}
 		LogError("Invalid version provided. Allowed values are: %s or a valid semver version", strings.Join(validArgs, ", "))
 		return err
 	}
@@ -196,11 +229,16 @@ func validateArg(arg string, allowedVersions map[string]bool) error {
 
 func readVersionFromFile() (string, error) {
 	// Get current directory
-	terraformVersionRegex := regexp.MustCompile(`^v?\d+\.\d+\.\d+$`)
 	cwd, err := os.Getwd()
 	if err != nil {
 		return "", fmt.Errorf("error getting current directory: %w", err)
 	}
+	return readVersionFromFileIn(cwd)
+}
+
+// readVersionFromFileIn returns the version from .terraform-version file in the directory
+func readVersionFromFileIn(cwd string) (string, error) {
+	terraformVersionRegex := regexp.MustCompile(`^v?\d+\.\d+\.\d+$`)
 	path := filepath.Join(cwd, terraformVersionFilename)
 
 	// Open file for reading
@@ -221,6 +259,7 @@ func readVersionFromFile() (string, error) {
 	}()
 
 	// Scan file line by line
+	aliases, _ := readAliases()
 	scanner := bufio.NewScanner(file)
 	for scanner.Scan() {
 		line := strings.TrimSpace(scanner.Text())
@@ -228,6 +267,9 @@ func readVersionFromFile() (string, error) {
 			terraformVersion := matches[0]
 			return terraformVersion, nil // Stop walking once we find the version, so it will be only first match
 		}
+		if _, ok := aliases[line]; ok {
+			return line, nil
+		}
 	}
 
 	if err := scanner.Err(); err != nil {
@@ -237,13 +279,143 @@ func readVersionFromFile() (string, error) {
 	return "", fmt.Errorf("no valid version found in %s", terraformVersionFilename)
 }
 
+// errDanglingActiveLink is returned when the active version symlink points to a removed version
+var errDanglingActiveLink = errors.New("dangling active version symlink")
+
 func getCurrentTerraformVersion() (string, error) {
 	currentTerraformBinPath, err := os.Readlink(currentTerraformVersionPath)
 	if err != nil {
 		return "", fmt.Errorf("failed to resolve symlink to current terraform version")
 	}
-	currentTerraformVersionPath := strings.Split(currentTerraformBinPath, "/")
-	currentTerraforVersion := currentTerraformVersionPath[len(currentTerraformVersionPath)-2]
+	// Symlink points to <terraformVersionPath>/<version>/terraform
+	currentTerraforVersion := filepath.Base(filepath.Dir(currentTerraformBinPath))
+
+	if _, err := os.Stat(currentTerraformBinPath); err != nil {
+		return "", fmt.Errorf("%w: %s points to Terraform v%s which is not installed, run tfenvgo use <version> to fix it",
+			errDanglingActiveLink, currentTerraformVersionPath, currentTerraforVersion)
+	}
+
+	return currentTerraforVersion, nil
+}
+
+// getProjectVersion returns the version requested for the current directory by
+// TFENVGO_TERRAFORM_VERSION or .terraform-version along with the source it was taken from.
+func getProjectVersion() (string, string, error) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return "", "", fmt.Errorf("error getting current directory: %w", err)
+	}
+	return getProjectVersionIn(cwd)
+}
+
+// getProjectVersionIn returns the version requested for the directory by
+// TFENVGO_TERRAFORM_VERSION or .terraform-version along with the source it was taken from.
+func getProjectVersionIn(dir string) (string, string, error) {
+	if version := getEnv(terraformVersionEnvKey, ""); version != "" {
+		return version, sourceEnv, nil
+	}
+	version, err := readVersionFromFileIn(dir)
+	if err != nil {
+		return "", "", err
+	}
+	return version, sourceFile, nil
+}
+
+// getRequestedVersion returns the version that would be used in the current directory when no argument is passed
+// along with the source it was taken from.
+func getRequestedVersion() (string, string, error) {
+	if version, source, err := getProjectVersion(); err == nil {
+		return version, source, nil
+	}
+	version, err := getCurrentTerraformVersion()
+	if err != nil {
+		return "", "", err
+	}
+	return version, sourceActive, nil
+}
+
+// resolveVersion turns a version argument (exact version, keyword or alias) into an exact version.
+// target is either "local" or "remote" and defines which list of versions keywords are resolved against.
+func resolveVersion(version string, versionRegex *regexp.Regexp, target string) (string, error) {
+	aliasVersion, aliasRegex, isAlias, err := expandAlias(version)
+	if err != nil {
+		return "", err
+	}
+	if isAlias {
+		if aliasRegex != nil {
+			LogInfo("Resolved alias %s to %s %q", version, aliasVersion, aliasRegex.String())
+		} else {
+			LogInfo("Resolved alias %s to %s", version, aliasVersion)
+		}
+		version, versionRegex = aliasVersion, aliasRegex
+	}
+
+	switch {
+	case version == latestArg && versionRegex == nil:
+		var versions []string
+		var err error
+		if target == "local" {
+			versions, err = getLocalTerraformVersions(PreReleaseVersionsIncluded)
+		} else {
+			versions, err = getRemoteTerraformVersions(PreReleaseVersionsIncluded)
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to get latest version: %w", err)
+		}
+		if len(versions) == 0 {
+			return "", fmt.Errorf("no %s versions found", target)
+		}
+		return versions[0], nil
+	case version == minRequiredArg:
+		minRequiredVersion, err := getMinRequired(target)
+		if err != nil {
+			return "", fmt.Errorf("failed to get minimum required version: %w", err)
+		}
+		return minRequiredVersion, nil
+	case version == latestAllowedArg:
+		latestAllowedVersion, err := getLatestAllowed(target, "")
+		if err != nil {
+			return "", fmt.Errorf("failed to get latest allowed version: %w", err)
+		}
+		return latestAllowedVersion, nil
+	case version == latestArg && versionRegex != nil:
+		// Historically the "regex" is evaluated as a semver constraint, keep that and fall back to a real regex
+		if _, err := semver.NewConstraint(versionRegex.String()); err != nil {
+			latestRegexVersion, err := getLatestMatching(target, versionRegex)
+			if err != nil {
+				return "", fmt.Errorf("failed to get latest regex version: %w", err)
+			}
+			return latestRegexVersion, nil
+		}
+		latestRegexVersion, err := getLatestAllowed(target, versionRegex.String())
+		if err != nil {
+			return "", fmt.Errorf("failed to get latest regex version: %w", err)
+		}
+		return latestRegexVersion, nil
+	}
+	return version, nil
+}
+
+// getLatestMatching returns the highest local or remote version matching the regex
+func getLatestMatching(target string, versionRegex *regexp.Regexp) (string, error) {
+	var terraformVersions []string
+	switch target {
+	case "local":
+		terraformVersions, _ = getLocalTerraformVersions(PreReleaseVersionsIncluded)
+	case "remote":
+		terraformVersions, _ = getRemoteTerraformVersions(PreReleaseVersionsIncluded)
+	}
+
+	// Versions are already sorted in descending order
+	for _, v := range terraformVersions {
+		if versionRegex.MatchString(v) {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("no available versions match %s", versionRegex.String())
+}
 
-	return currentTerraforVersion, err
+// getPlatform returns OS type and architecture of Terraform builds to install
+func getPlatform() (string, string) {
+	return getEnv(osTypeEnvKey, defaultOSType), getEnv(archEnvKey, defaultArch)
 }
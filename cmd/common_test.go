@@ -0,0 +1,61 @@
+/*
+Copyright © 2025 Denys Makeienko <denys.makeienko@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"os"
+	"runtime"
+	"testing"
+)
+
+func TestGetPlatform(t *testing.T) {
+	tests := []struct {
+		name         string
+		osType, arch string
+		wantOS       string
+		wantArch     string
+		wantArchive  string
+	}{
+		{"defaults", "", "", runtime.GOOS, runtime.GOARCH, "terraform_1.5.7_" + runtime.GOOS + "_" + runtime.GOARCH + ".zip"},
+		{"os type only", "windows", "", "windows", runtime.GOARCH, "terraform_1.5.7_windows_" + runtime.GOARCH + ".zip"},
+		{"arch only", "", "arm64", runtime.GOOS, "arm64", "terraform_1.5.7_" + runtime.GOOS + "_arm64.zip"},
+		{"both", "darwin", "amd64", "darwin", "amd64", "terraform_1.5.7_darwin_amd64.zip"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for key, value := range map[string]string{osTypeEnvKey: tt.osType, archEnvKey: tt.arch} {
+				// t.Setenv restores the original value, unset afterwards to test the defaults
+				t.Setenv(key, value)
+				if value == "" {
+					_ = os.Unsetenv(key)
+				}
+			}
+			osType, arch := getPlatform()
+			if osType != tt.wantOS || arch != tt.wantArch {
+				t.Fatalf("getPlatform() = %s, %s, want %s, %s", osType, arch, tt.wantOS, tt.wantArch)
+			}
+			if archive := getArchiveName("1.5.7", osType, arch); archive != tt.wantArchive {
+				t.Errorf("getArchiveName() = %s, want %s", archive, tt.wantArchive)
+			}
+		})
+	}
+}
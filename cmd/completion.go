@@ -0,0 +1,142 @@
+/*
+Copyright © 2025 Denys Makeienko <denys.makeienko@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/spf13/cobra"
+)
+
+// completeVersions returns a completion function for version arguments. It reads only local
+// versions, aliases and the cached remote version list, so it never waits for the network.
+func completeVersions(includeRemote bool, keywords ...string) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		var candidates []string
+		candidates = append(candidates, keywords...)
+
+		aliases, _ := readAliases()
+		aliasNames := make([]string, 0, len(aliases))
+		for name := range aliases {
+			aliasNames = append(aliasNames, name)
+		}
+		sort.Strings(aliasNames)
+		candidates = append(candidates, aliasNames...)
+
+		versions, _ := getLocalTerraformVersions(PreReleaseVersionsIncluded)
+		if includeRemote {
+			if cache, err := readRemoteVersionCache(); err == nil {
+				stableVersionRegex := regexp.MustCompile(`^[0-9]+\.[0-9]+\.[0-9]+$`)
+				for _, v := range cache.Versions {
+					if PreReleaseVersionsIncluded || stableVersionRegex.MatchString(v) {
+						versions = append(versions, v)
+					}
+				}
+			}
+		}
+		candidates = append(candidates, sortVersionsDesc(versions)...)
+
+		var completions []string
+		for _, candidate := range candidates {
+			if strings.HasPrefix(candidate, toComplete) {
+				completions = append(completions, candidate)
+			}
+		}
+		// Keep the order: keywords, aliases, then versions from newest to oldest
+		return completions, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveKeepOrder
+	}
+}
+
+func init() {
+	installCmd.ValidArgsFunction = completeVersions(true, latestArg, latestAllowedArg, minRequiredArg)
+	useCmd.ValidArgsFunction = completeVersions(false, latestArg, latestAllowedArg, minRequiredArg)
+	uninstallCmd.ValidArgsFunction = completeUninstallVersions
+	whichCmd.ValidArgsFunction = completeVersions(false, latestArg, latestAllowedArg, minRequiredArg)
+	verifyCmd.ValidArgsFunction = completeVersions(false, latestArg)
+	repairCmd.ValidArgsFunction = completeVersions(false, latestArg)
+	infoCmd.ValidArgsFunction = completeVersions(true, latestArg, latestAllowedArg, minRequiredArg)
+	direnvCmd.ValidArgsFunction = completeVersions(true, latestArg, latestAllowedArg, minRequiredArg)
+	aliasRmCmd.ValidArgsFunction = completeAliases
+}
+
+// completeUninstallVersions completes any number of installed versions, skipping already given ones
+func completeUninstallVersions(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) == 0 {
+		return completeVersions(false, latestArg)(cmd, args, toComplete)
+	}
+	if args[0] == latestArg {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	candidates, directive := completeVersions(false)(cmd, nil, toComplete)
+	var completions []string
+	for _, candidate := range candidates {
+		if !containsString(args, candidate) {
+			completions = append(completions, candidate)
+		}
+	}
+	return completions, directive
+}
+
+// completeAliases completes alias names
+func completeAliases(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	aliases, _ := readAliases()
+	var completions []string
+	for name := range aliases {
+		if strings.HasPrefix(name, toComplete) {
+			completions = append(completions, name)
+		}
+	}
+	sort.Strings(completions)
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// sortVersionsDesc returns unique valid versions sorted from newest to oldest
+func sortVersionsDesc(versionStrings []string) []string {
+	seen := make(map[string]bool)
+	var versions []*semver.Version
+	for _, v := range versionStrings {
+		if seen[v] {
+			continue
+		}
+		seen[v] = true
+		if version, err := semver.NewVersion(v); err == nil {
+			versions = append(versions, version)
+		}
+	}
+	sort.Sort(sort.Reverse(semver.Collection(versions)))
+
+	sorted := make([]string, 0, len(versions))
+	for _, v := range versions {
+		sorted = append(sorted, v.String())
+	}
+	return sorted
+}
@@ -1,12 +1,15 @@
 package cmd
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"runtime"
 )
 
-const terraformReleasesURL = "https://releases.hashicorp.com/terraform"
+const defaultMirrorURL = "https://releases.hashicorp.com/terraform"
+const terraformReleasesAPIURL = "https://api.releases.hashicorp.com/v1/releases/terraform"
+const defaultSelfUpdateURL = "https://api.github.com/repos/dmakeienko/tfenvgo/releases"
 
 // getUserHomeDir safely gets the user home directory
 func getUserHomeDir() (string, error) {
@@ -17,6 +20,42 @@ func getUserHomeDir() (string, error) {
 	return homeDir, nil
 }
 
+// getRootDirs returns data, cache and config directories. An explicit root from --root or TFENVGO_ROOT
+// holds everything. Otherwise XDG base directories are used when set, unless ~/.tfenvgo already exists.
+func getRootDirs(homeDir string) (string, string, string, error) {
+	root := rootFlag
+	if root == "" {
+		root = getEnv(rootEnvKey, "")
+	}
+	if root != "" {
+		absRoot, err := filepath.Abs(root)
+		if err != nil {
+			return "", "", "", fmt.Errorf("invalid root directory %s: %w", root, err)
+		}
+		return absRoot, filepath.Join(absRoot, "cache"), absRoot, nil
+	}
+
+	legacyRoot := filepath.Join(homeDir, ".tfenvgo")
+	dataDir, cache, configDir := legacyRoot, filepath.Join(legacyRoot, "cache"), legacyRoot
+	if _, err := os.Stat(legacyRoot); err == nil {
+		return dataDir, cache, configDir, nil
+	}
+	if xdgDataHome := os.Getenv("XDG_DATA_HOME"); filepath.IsAbs(xdgDataHome) {
+		dataDir = filepath.Join(xdgDataHome, "tfenvgo")
+	}
+	if xdgCacheHome := os.Getenv("XDG_CACHE_HOME"); filepath.IsAbs(xdgCacheHome) {
+		cache = filepath.Join(xdgCacheHome, "tfenvgo")
+	} else {
+		cache = filepath.Join(dataDir, "cache")
+	}
+	if xdgConfigHome := os.Getenv("XDG_CONFIG_HOME"); filepath.IsAbs(xdgConfigHome) {
+		configDir = filepath.Join(xdgConfigHome, "tfenvgo")
+	} else {
+		configDir = dataDir
+	}
+	return dataDir, cache, configDir, nil
+}
+
 // Initialize paths safely
 func initPaths() error {
 	homeDir, err := getUserHomeDir()
@@ -24,10 +63,20 @@ func initPaths() error {
 		return err
 	}
 
-	rootURL = filepath.Join(homeDir, ".tfenvgo")
+	dataDir, cache, configDir, err := getRootDirs(homeDir)
+	if err != nil {
+		return err
+	}
+
+	rootURL = dataDir
 	terraformBinPath = filepath.Join(rootURL, "bin")
 	terraformVersionPath = filepath.Join(rootURL, "versions")
 	currentTerraformVersionPath = filepath.Join(terraformBinPath, "terraform")
+	aliasesFilePath = filepath.Join(rootURL, aliasesFilename)
+	usageFilePath = filepath.Join(rootURL, usageFilename)
+	cacheDir = cache
+	remoteCacheFilePath = filepath.Join(cacheDir, remoteCacheFilename)
+	userConfigFilePath = filepath.Join(configDir, userConfigFilename)
 
 	return nil
 }
@@ -37,8 +86,16 @@ var (
 	terraformBinPath            string
 	terraformVersionPath        string
 	currentTerraformVersionPath string
+	aliasesFilePath             string
+	usageFilePath               string
+	cacheDir                    string
+	remoteCacheFilePath         string
+	userConfigFilePath          string
 )
 
+// rootFlag is the value of the global --root flag
+var rootFlag string
+
 // System
 var defaultArch = runtime.GOARCH
 var defaultOSType = runtime.GOOS
@@ -55,9 +112,24 @@ var Gray = "\033[37m"
 var White = "\033[97m"
 
 // Environment variables
+const rootEnvKey = "TFENVGO_ROOT"
 const archEnvKey = "TFENVGO_ARCH"
 const osTypeEnvKey = "TFENVGO_OS_TYPE"
 const terraformVersionEnvKey = "TFENVGO_TERRAFORM_VERSION"
+const remoteCacheTTLEnvKey = "TFENVGO_REMOTE_CACHE_TTL"
+const changelogSourceEnvKey = "TFENVGO_CHANGELOG_SOURCE"
+const supportDataEnvKey = "TFENVGO_SUPPORT_DATA"
+const mirrorEnvKey = "TFENVGO_MIRROR"
+const includePrereleaseEnvKey = "TFENVGO_INCLUDE_PRERELEASE"
+const autoInstallEnvKey = "TFENVGO_AUTO_INSTALL"
+const logLevelEnvKey = "TFENVGO_LOG_LEVEL"
+const retentionKeepEnvKey = "TFENVGO_RETENTION_KEEP"
+const retentionKeepLatestPerMinorEnvKey = "TFENVGO_RETENTION_KEEP_LATEST_PER_MINOR"
+const retentionOlderThanEnvKey = "TFENVGO_RETENTION_OLDER_THAN"
+const retentionUnusedForEnvKey = "TFENVGO_RETENTION_UNUSED_FOR"
+const verificationEnvKey = "TFENVGO_VERIFICATION"
+const linkModeEnvKey = "TFENVGO_LINK_MODE"
+const selfUpdateURLEnvKey = "TFENVGO_SELF_UPDATE_URL"
 
 // Arguments
 const (
@@ -66,7 +138,21 @@ const (
 	minRequiredArg   = "min-required"
 )
 
+// Version sources
+const (
+	sourceArgument   = "argument"
+	sourceEnv        = "environment"
+	sourceFile       = "file"
+	sourceConstraint = "required_version"
+	sourceActive     = "active"
+)
+
 const terraformVersionFilename string = ".terraform-version"
+const aliasesFilename string = "aliases.json"
+const usageFilename string = "usage.json"
+const remoteCacheFilename string = "remote-versions.json"
+const userConfigFilename string = "config.yaml"
+const repoConfigFilename string = ".tfenvgo.yaml"
 
 // flags
 var PreReleaseVersionsIncluded bool
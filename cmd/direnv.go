@@ -0,0 +1,120 @@
+/*
+Copyright © 2025 Denys Makeienko <denys.makeienko@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/spf13/cobra"
+)
+
+var direnvNoInstall bool
+
+// getDirectoryVersion returns the version requested for the current directory along with the source it was taken from,
+// falling back to latest-allowed when only required_version is present in *.tf files.
+func getDirectoryVersion() (string, string, error) {
+	if version, source, err := getProjectVersion(); err == nil {
+		return version, source, nil
+	}
+	if _, err := getTerraformVersionConstraint(); err == nil {
+		return latestAllowedArg, sourceConstraint, nil
+	}
+	return "", "", fmt.Errorf("neither %s nor required_version found in current directory", terraformVersionFilename)
+}
+
+// direnvCmd represents the direnv command
+var direnvCmd = &cobra.Command{
+	Use:   "direnv [version]",
+	Short: "Print the directory with the Terraform binary for the current directory, used by direnv integration",
+	Long: `Resolve the Terraform version for the current directory, install it if needed and print
+the directory containing its binary. Used by the use_tfenvgo function printed by "tfenvgo hook direnv".`,
+	Args: cobra.MaximumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		// stdout is consumed by direnv, so logs go to stderr
+		SetLogOutput(os.Stderr)
+		if !flagChanged(cmd, "no-install") {
+			direnvNoInstall = !getSettingBool("auto_install")
+		}
+
+		var version, source string
+		var versionRegex *regexp.Regexp
+		if len(args) == 0 {
+			directoryVersion, directorySource, err := getDirectoryVersion()
+			if err != nil {
+				FatalError("Failed to detect terraform version: %v", err)
+			}
+			version, source = directoryVersion, directorySource
+		} else {
+			version, source = args[0], sourceArgument
+			if len(args) == 2 && args[0] == latestArg {
+				regex, err := regexp.Compile(args[1])
+				if err != nil {
+					FatalError("Invalid regex %q: %v", args[1], err)
+				}
+				versionRegex = regex
+			}
+		}
+
+		allowedVersions := map[string]bool{
+			latestArg:        true,
+			latestAllowedArg: true,
+			minRequiredArg:   true,
+		}
+
+		if validateArg(version, allowedVersions) != nil {
+			os.Exit(1)
+		}
+
+		target := "remote"
+		if direnvNoInstall {
+			target = "local"
+		}
+		version, err := resolveVersion(version, versionRegex, target)
+		if err != nil {
+			FatalError("%v", err)
+		}
+
+		versionDir := filepath.Join(terraformVersionPath, version)
+		if _, err := os.Stat(filepath.Join(versionDir, "terraform")); err != nil {
+			if direnvNoInstall {
+				FatalError("Terraform v%s is not installed", version)
+			}
+			if err := installTerraform(version); err != nil {
+				os.Exit(1)
+			}
+		}
+		// Only directories pinning the version in .terraform-version are projects
+		if source == sourceFile {
+			recordProjectUsage(version)
+		}
+		fmt.Println(versionDir)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(direnvCmd)
+	direnvCmd.Flags().BoolVarP(&direnvNoInstall, "no-install", "", false, "Do not install the version if it is missing")
+	direnvCmd.Flags().BoolVarP(&PreReleaseVersionsIncluded, "include-prerelease", "", false, "Include pre-release versions")
+}
@@ -0,0 +1,395 @@
+/*
+Copyright © 2025 Denys Makeienko <denys.makeienko@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"debug/elf"
+	"debug/macho"
+	"debug/pe"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// Doctor check statuses
+const (
+	doctorOK    = "ok"
+	doctorWarn  = "warn"
+	doctorError = "error"
+)
+
+// staleTempFileAge is the age after which leftover temp files are reported
+const staleTempFileAge = time.Hour
+
+// doctorCheck is the result of a single diagnostic check
+type doctorCheck struct {
+	Name    string `json:"name" yaml:"name"`
+	Status  string `json:"status" yaml:"status"`
+	Message string `json:"message" yaml:"message"`
+	Fix     string `json:"fix,omitempty" yaml:"fix,omitempty"`
+}
+
+var doctorJSONOutput bool
+
+// binaryPlatform returns OS and architecture of an executable from its ELF, Mach-O or PE header
+func binaryPlatform(path string) (string, string, error) {
+	if f, err := elf.Open(path); err == nil {
+		defer f.Close()
+		arch := map[elf.Machine]string{
+			elf.EM_X86_64:  "amd64",
+			elf.EM_386:     "386",
+			elf.EM_AARCH64: "arm64",
+			elf.EM_ARM:     "arm",
+		}[f.Machine]
+		osType := "linux"
+		if f.OSABI == elf.ELFOSABI_FREEBSD {
+			osType = "freebsd"
+		}
+		return osType, arch, nil
+	}
+	if f, err := macho.Open(path); err == nil {
+		defer f.Close()
+		return "darwin", machoArch(f.Cpu), nil
+	}
+	if f, err := macho.OpenFat(path); err == nil {
+		defer f.Close()
+		// Universal binaries run on any of the included architectures, prefer the host one
+		arch := machoArch(f.Arches[0].Cpu)
+		for _, a := range f.Arches {
+			if machoArch(a.Cpu) == runtime.GOARCH {
+				arch = runtime.GOARCH
+			}
+		}
+		return "darwin", arch, nil
+	}
+	if f, err := pe.Open(path); err == nil {
+		defer f.Close()
+		arch := map[uint16]string{
+			pe.IMAGE_FILE_MACHINE_AMD64: "amd64",
+			pe.IMAGE_FILE_MACHINE_I386:  "386",
+			pe.IMAGE_FILE_MACHINE_ARM64: "arm64",
+		}[f.Machine]
+		return "windows", arch, nil
+	}
+	return "", "", errors.New("unrecognized executable format")
+}
+
+func machoArch(cpu macho.Cpu) string {
+	switch cpu {
+	case macho.CpuAmd64:
+		return "amd64"
+	case macho.Cpu386:
+		return "386"
+	case macho.CpuArm64:
+		return "arm64"
+	case macho.CpuArm:
+		return "arm"
+	}
+	return ""
+}
+
+// checkRootDir verifies that the root directory exists and is writable
+func checkRootDir() doctorCheck {
+	check := doctorCheck{Name: "root directory"}
+	stat, err := os.Stat(rootURL)
+	if err != nil {
+		check.Status = doctorError
+		check.Message = fmt.Sprintf("%s is not accessible: %v", rootURL, err)
+		check.Fix = "Run tfenvgo init"
+		return check
+	}
+	if !stat.IsDir() {
+		check.Status = doctorError
+		check.Message = fmt.Sprintf("%s is not a directory", rootURL)
+		check.Fix = fmt.Sprintf("Move %s away and run tfenvgo init", rootURL)
+		return check
+	}
+	for _, dir := range []string{rootURL, terraformBinPath, terraformVersionPath} {
+		tmpFile, err := os.CreateTemp(dir, ".doctor-*")
+		if err != nil {
+			check.Status = doctorError
+			check.Message = fmt.Sprintf("%s is not writable: %v", dir, err)
+			check.Fix = fmt.Sprintf("Run: chmod u+rwx %s", dir)
+			return check
+		}
+		_ = tmpFile.Close()
+		_ = os.Remove(tmpFile.Name())
+	}
+	check.Status = doctorOK
+	check.Message = fmt.Sprintf("%s is writable", rootURL)
+	return check
+}
+
+// checkPath verifies that the bin directory is on PATH and no other terraform shadows it
+func checkPath() []doctorCheck {
+	pathCheck := doctorCheck{Name: "PATH"}
+	shadowCheck := doctorCheck{Name: "PATH order"}
+
+	binIndex := -1
+	var shadowing []string
+	for i, dir := range filepath.SplitList(os.Getenv("PATH")) {
+		if dir == "" {
+			continue
+		}
+		if filepath.Clean(dir) == filepath.Clean(terraformBinPath) {
+			if binIndex < 0 {
+				binIndex = i
+			}
+			continue
+		}
+		if binIndex >= 0 {
+			continue
+		}
+		candidate := filepath.Join(dir, "terraform")
+		if runtime.GOOS == "windows" {
+			candidate += ".exe"
+		}
+		if stat, err := os.Stat(candidate); err == nil && !stat.IsDir() && stat.Mode()&0o111 != 0 {
+			shadowing = append(shadowing, candidate)
+		}
+	}
+
+	if binIndex < 0 {
+		pathCheck.Status = doctorError
+		pathCheck.Message = fmt.Sprintf("%s is not on PATH", terraformBinPath)
+		pathCheck.Fix = fmt.Sprintf("Add to your shell profile: export PATH=\"%s:$PATH\"", terraformBinPath)
+	} else {
+		pathCheck.Status = doctorOK
+		pathCheck.Message = fmt.Sprintf("%s is on PATH", terraformBinPath)
+	}
+
+	switch {
+	case binIndex < 0:
+		shadowCheck.Status = doctorWarn
+		shadowCheck.Message = "skipped, bin directory is not on PATH"
+	case len(shadowing) > 0:
+		shadowCheck.Status = doctorError
+		shadowCheck.Message = fmt.Sprintf("terraform found earlier on PATH shadows tfenvgo: %s", strings.Join(shadowing, ", "))
+		shadowCheck.Fix = fmt.Sprintf("Remove these binaries or put %s first on PATH", terraformBinPath)
+	default:
+		shadowCheck.Status = doctorOK
+		shadowCheck.Message = "no other terraform found earlier on PATH"
+	}
+	return []doctorCheck{pathCheck, shadowCheck}
+}
+
+// checkActiveVersion verifies that the active version symlink is valid and the binary is executable
+func checkActiveVersion() []doctorCheck {
+	linkCheck := doctorCheck{Name: "active version"}
+	execCheck := doctorCheck{Name: "active binary"}
+
+	version, err := getCurrentTerraformVersion()
+	if err != nil {
+		if _, lerr := os.Lstat(currentTerraformVersionPath); lerr != nil {
+			linkCheck.Status = doctorWarn
+			linkCheck.Message = "no active version is set"
+		} else {
+			linkCheck.Status = doctorError
+			linkCheck.Message = err.Error()
+		}
+		linkCheck.Fix = "Run: tfenvgo use <version>"
+		execCheck.Status = doctorWarn
+		execCheck.Message = "skipped, no valid active version"
+		return []doctorCheck{linkCheck, execCheck}
+	}
+	linkCheck.Status = doctorOK
+	linkCheck.Message = fmt.Sprintf("Terraform v%s", version)
+
+	binary := filepath.Join(terraformVersionPath, version, "terraform")
+	stat, err := os.Stat(binary)
+	switch {
+	case err != nil:
+		execCheck.Status = doctorError
+		execCheck.Message = err.Error()
+	case stat.Mode()&0o111 == 0:
+		execCheck.Status = doctorError
+		execCheck.Message = fmt.Sprintf("%s is not executable", binary)
+		execCheck.Fix = fmt.Sprintf("Run: chmod 755 %s", binary)
+	default:
+		execCheck.Status = doctorOK
+		execCheck.Message = fmt.Sprintf("%s is executable", binary)
+	}
+	return []doctorCheck{linkCheck, execCheck}
+}
+
+// checkInstalledBinaries verifies that every installed version is complete and built for the host platform
+func checkInstalledBinaries() doctorCheck {
+	check := doctorCheck{Name: "installed binaries"}
+	versions, err := getLocalTerraformVersions(true)
+	if err != nil {
+		check.Status = doctorWarn
+		check.Message = fmt.Sprintf("failed to list installed versions: %v", err)
+		return check
+	}
+
+	var problems, fixes []string
+	for _, version := range versions {
+		binary := filepath.Join(terraformVersionPath, version, "terraform")
+		if _, err := os.Stat(binary); err != nil {
+			problems = append(problems, fmt.Sprintf("v%s is incomplete, %s is missing", version, binary))
+			fixes = append(fixes, fmt.Sprintf("tfenvgo repair %s", version))
+			continue
+		}
+		osType, arch, err := binaryPlatform(binary)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("v%s: %v", version, err))
+			continue
+		}
+		if osType != runtime.GOOS || arch != runtime.GOARCH {
+			problems = append(problems, fmt.Sprintf("v%s is built for %s/%s, host is %s/%s", version, osType, arch, runtime.GOOS, runtime.GOARCH))
+			fixes = append(fixes, fmt.Sprintf("unset %s %s && tfenvgo uninstall %s && tfenvgo install %s", archEnvKey, osTypeEnvKey, version, version))
+		}
+	}
+
+	if len(problems) > 0 {
+		check.Status = doctorError
+		check.Message = strings.Join(problems, "; ")
+		check.Fix = strings.Join(fixes, "; ")
+		return check
+	}
+	check.Status = doctorOK
+	check.Message = fmt.Sprintf("%d version(s) built for %s/%s", len(versions), runtime.GOOS, runtime.GOARCH)
+
+	osType, arch := getPlatform()
+	if osType != runtime.GOOS || arch != runtime.GOARCH {
+		check.Status = doctorWarn
+		check.Message += fmt.Sprintf(", but new versions will be installed for %s/%s", osType, arch)
+		check.Fix = fmt.Sprintf("Unset %s and %s unless installing for another platform is intended", archEnvKey, osTypeEnvKey)
+	}
+	return check
+}
+
+// checkMirror verifies that the releases site is reachable
+func checkMirror() doctorCheck {
+	check := doctorCheck{Name: "mirror"}
+	resp, err := httpRequest("HEAD", getMirrorURL()+"/")
+	if err != nil {
+		check.Status = doctorError
+		check.Message = err.Error()
+		check.Fix = "Check network connectivity and HTTPS_PROXY/HTTP_PROXY settings"
+		return check
+	}
+	resp.Body.Close()
+	check.Status = doctorOK
+	check.Message = fmt.Sprintf("%s is reachable", getMirrorURL())
+	return check
+}
+
+// checkStaleTempFiles looks for leftovers of interrupted downloads and cache writes
+func checkStaleTempFiles() doctorCheck {
+	check := doctorCheck{Name: "temp files"}
+	patterns := []string{
+		filepath.Join(os.TempDir(), "tfenvgo-*.zip"),
+		filepath.Join(cacheDir, ".remote-versions-*.json"),
+		filepath.Join(rootURL, ".doctor-*"),
+	}
+	var stale []string
+	for _, pattern := range patterns {
+		matches, _ := filepath.Glob(pattern)
+		for _, match := range matches {
+			if stat, err := os.Stat(match); err == nil && time.Since(stat.ModTime()) > staleTempFileAge {
+				stale = append(stale, match)
+			}
+		}
+	}
+	if len(stale) > 0 {
+		check.Status = doctorWarn
+		check.Message = fmt.Sprintf("stale temp files found: %s", strings.Join(stale, ", "))
+		check.Fix = "Run: rm " + strings.Join(stale, " ")
+		return check
+	}
+	check.Status = doctorOK
+	check.Message = "no stale temp files"
+	return check
+}
+
+func runDoctorChecks() []doctorCheck {
+	var checks []doctorCheck
+	checks = append(checks, checkRootDir())
+	checks = append(checks, checkPath()...)
+	checks = append(checks, checkActiveVersion()...)
+	checks = append(checks, checkInstalledBinaries())
+	checks = append(checks, checkMirror())
+	checks = append(checks, checkStaleTempFiles())
+	return checks
+}
+
+func printDoctorChecks(checks []doctorCheck) {
+	for _, check := range checks {
+		var label string
+		switch check.Status {
+		case doctorOK:
+			label = Green + "[OK]   " + Reset
+		case doctorWarn:
+			label = Yellow + "[WARN] " + Reset
+		default:
+			label = Red + "[FAIL] " + Reset
+		}
+		fmt.Printf("%s%s: %s\n", label, check.Name, check.Message)
+		if check.Fix != "" {
+			fmt.Printf("       fix: %s\n", check.Fix)
+		}
+	}
+}
+
+// doctorCmd represents the doctor command
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Diagnose common problems with the tfenvgo installation",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if doctorJSONOutput {
+			outputFormat = outputJSON
+		}
+		if err := setupOutputFormats(outputText, outputJSON, outputYAML); err != nil {
+			FatalError("%v", err)
+		}
+
+		checks := runDoctorChecks()
+		switch outputFormat {
+		case outputJSON, outputYAML:
+			if err := printStructured(os.Stdout, checks); err != nil {
+				FatalError("%v", err)
+			}
+		default:
+			printDoctorChecks(checks)
+		}
+
+		for _, check := range checks {
+			if check.Status == doctorError {
+				os.Exit(1)
+			}
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(doctorCmd)
+	doctorCmd.Flags().BoolVarP(&doctorJSONOutput, "json", "", false, "Output in JSON format, same as --output json")
+	doctorCmd.Flags().StringVarP(&outputFormat, "output", "o", outputText, "Output format: text, json or yaml")
+}
@@ -0,0 +1,143 @@
+/*
+Copyright © 2025 Denys Makeienko <denys.makeienko@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// Shell integration scripts. %[1]s is the tfenvgo executable, %[2]s is the tfenvgo bin directory.
+// direnv script defines use_tfenvgo function to be added to ~/.config/direnv/direnvrc.
+var shellHooks = map[string]string{
+	"bash": `case ":${PATH}:" in
+  *:%[2]s:*) ;;
+  *) export PATH=%[2]s:"${PATH}" ;;
+esac
+
+_tfenvgo_hook() {
+  local previous_exit_status=$?
+  if [ "${PWD}" != "${_TFENVGO_LAST_PWD:-}" ]; then
+    _TFENVGO_LAST_PWD="${PWD}"
+    %[1]s use --auto
+  fi
+  return $previous_exit_status
+}
+
+if [[ ";${PROMPT_COMMAND[*]:-};" != *";_tfenvgo_hook;"* ]]; then
+  PROMPT_COMMAND="_tfenvgo_hook${PROMPT_COMMAND:+;${PROMPT_COMMAND}}"
+fi
+`,
+	"zsh": `case ":${PATH}:" in
+  *:%[2]s:*) ;;
+  *) export PATH=%[2]s:"${PATH}" ;;
+esac
+
+_tfenvgo_hook() {
+  %[1]s use --auto
+}
+
+autoload -Uz add-zsh-hook
+add-zsh-hook chpwd _tfenvgo_hook
+_tfenvgo_hook
+`,
+	"fish": `if not contains -- %[2]s $PATH
+    set -gx PATH %[2]s $PATH
+end
+
+function _tfenvgo_hook --on-variable PWD
+    %[1]s use --auto
+end
+
+_tfenvgo_hook
+`,
+	"direnv": `use_tfenvgo() {
+  watch_file .terraform-version
+  local tf_file
+  for tf_file in ./*.tf; do
+    [ -e "${tf_file}" ] && watch_file "${tf_file}"
+  done
+
+  local terraform_dir
+  terraform_dir="$(%[1]s direnv "$@")" || return 1
+  PATH_add "${terraform_dir}"
+}
+`,
+}
+
+// shellQuote quotes the string to be safely used in POSIX shells and fish
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// getExecutablePath returns the absolute path of the running tfenvgo binary, falling back to its name
+func getExecutablePath() string {
+	executable, err := os.Executable()
+	if err != nil {
+		return "tfenvgo"
+	}
+	return executable
+}
+
+func supportedShells() []string {
+	shells := make([]string, 0, len(shellHooks))
+	for shell := range shellHooks {
+		shells = append(shells, shell)
+	}
+	sort.Strings(shells)
+	return shells
+}
+
+// hookCmd represents the hook command
+var hookCmd = &cobra.Command{
+	Use:   "hook <shell>",
+	Short: "Print shell integration script that switches versions on directory change",
+	Long: `Print shell integration script that adds tfenvgo bin directory to PATH and switches
+Terraform version when entering a directory with a .terraform-version file.
+
+Add one of the following lines to your shell config:
+
+  eval "$(tfenvgo hook bash)"   # ~/.bashrc
+  eval "$(tfenvgo hook zsh)"    # ~/.zshrc
+  tfenvgo hook fish | source    # ~/.config/fish/config.fish
+
+For direnv, add use_tfenvgo function to ~/.config/direnv/direnvrc and call it from .envrc:
+
+  tfenvgo hook direnv >> ~/.config/direnv/direnvrc
+  echo use tfenvgo >> .envrc`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		hook, ok := shellHooks[args[0]]
+		if !ok {
+			FatalError("Unsupported shell %q, supported shells are: %s", args[0], strings.Join(supportedShells(), ", "))
+		}
+		fmt.Printf(hook, shellQuote(getExecutablePath()), shellQuote(terraformBinPath))
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(hookCmd)
+}
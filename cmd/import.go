@@ -0,0 +1,298 @@
+/*
+Copyright © 2025 Denys Makeienko <denys.makeienko@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/spf13/cobra"
+)
+
+// Import sources
+const (
+	importFromTfenv    = "tfenv"
+	importFromTfswitch = "tfswitch"
+	importFromDir      = "dir"
+)
+
+var (
+	importFrom string
+	importLink bool
+)
+
+// importCandidate is a Terraform binary found in another version manager
+type importCandidate struct {
+	Version string
+	Binary  string
+}
+
+// getDefaultImportPath returns the default installation directory of the version manager
+func getDefaultImportPath(from string) (string, error) {
+	homeDir, err := getUserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	switch from {
+	case importFromTfenv:
+		if root := os.Getenv("TFENV_CONFIG_DIR"); root != "" {
+			return root, nil
+		}
+		return filepath.Join(homeDir, ".tfenv"), nil
+	case importFromTfswitch:
+		return filepath.Join(homeDir, ".terraform.versions"), nil
+	}
+	return "", fmt.Errorf("path is required for --from %s", from)
+}
+
+// discoverImportCandidates finds <version>/terraform directories and terraform_<version> files in dir
+func discoverImportCandidates(dir string) ([]importCandidate, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", dir, err)
+	}
+	versionRegex := regexp.MustCompile(`^v?(\d+\.\d+\.\d+(-[a-z]+\d+)?)$`)
+	fileRegex := regexp.MustCompile(`^terraform_v?(\d+\.\d+\.\d+(-[a-z]+\d+)?)(\.exe)?$`)
+
+	var candidates []importCandidate
+	for _, entry := range entries {
+		name := entry.Name()
+		if matches := versionRegex.FindStringSubmatch(name); matches != nil && entry.IsDir() {
+			binary := filepath.Join(dir, name, "terraform")
+			if _, err := os.Stat(binary); err == nil {
+				candidates = append(candidates, importCandidate{Version: matches[1], Binary: binary})
+			}
+			continue
+		}
+		if matches := fileRegex.FindStringSubmatch(name); matches != nil && entry.Type().IsRegular() {
+			candidates = append(candidates, importCandidate{Version: matches[1], Binary: filepath.Join(dir, name)})
+		}
+	}
+	return candidates, nil
+}
+
+// getBinaryVersion runs the binary and returns the version it reports
+func getBinaryVersion(binary string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// #nosec G204 -- running a Terraform binary is intended
+	output, err := exec.CommandContext(ctx, binary, "version", "-json").Output()
+	if err == nil {
+		var result struct {
+			TerraformVersion string `json:"terraform_version"`
+		}
+		if jsonErr := json.Unmarshal(output, &result); jsonErr == nil && result.TerraformVersion != "" {
+			return result.TerraformVersion, nil
+		}
+	}
+
+	// Versions before 0.13 don't support -json
+	// #nosec G204 -- running a Terraform binary is intended
+	output, err = exec.CommandContext(ctx, binary, "version").Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to run %s version: %w", binary, err)
+	}
+	matches := regexp.MustCompile(`Terraform v(\S+)`).FindStringSubmatch(string(output))
+	if matches == nil {
+		return "", fmt.Errorf("unexpected output of %s version", binary)
+	}
+	return matches[1], nil
+}
+
+// copyFile copies the file with the given permissions
+func copyFile(src, dst string, perm os.FileMode) error {
+	in, err := os.Open(src) // #nosec G304 -- copying user provided file is intended
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// importVersion validates the binary and copies or hard-links it into the versions directory
+func importVersion(candidate importCandidate, link bool) error {
+	reported, err := getBinaryVersion(candidate.Binary)
+	if err != nil {
+		return err
+	}
+	reportedVersion, err := semver.NewVersion(reported)
+	if err != nil {
+		return fmt.Errorf("binary reports invalid version %s", reported)
+	}
+	expectedVersion, err := semver.NewVersion(candidate.Version)
+	if err != nil || !reportedVersion.Equal(expectedVersion) {
+		return fmt.Errorf("binary reports version %s, expected %s", reported, candidate.Version)
+	}
+
+	dstDir := filepath.Join(terraformVersionPath, candidate.Version)
+	if err := os.MkdirAll(dstDir, 0o750); err != nil {
+		return fmt.Errorf("failed to create %s: %w", dstDir, err)
+	}
+	dst := filepath.Join(dstDir, "terraform")
+
+	linked := false
+	if link {
+		err := os.Link(candidate.Binary, dst)
+		if err == nil {
+			linked = true
+		} else {
+			LogWarn("Failed to hard-link %s, copying instead: %v", candidate.Binary, err)
+		}
+	}
+	if !linked {
+		if err := copyFile(candidate.Binary, dst, 0o755); err != nil {
+			_ = os.RemoveAll(dstDir)
+			return fmt.Errorf("failed to copy %s: %w", candidate.Binary, err)
+		}
+	}
+
+	source, err := filepath.Abs(candidate.Binary)
+	if err != nil {
+		source = candidate.Binary
+	}
+	osType, arch, err := binaryPlatform(dst)
+	if err != nil {
+		osType, arch = getPlatform()
+	}
+	recordInstallManifest(candidate.Version, (&url.URL{Scheme: "file", Path: filepath.ToSlash(source)}).String(), "", osType, arch, "")
+	return nil
+}
+
+// readTfenvDefaultVersion returns the global version selected in tfenv
+func readTfenvDefaultVersion(root string) (string, error) {
+	data, err := os.ReadFile(filepath.Join(root, "version")) // #nosec G304 -- reading tfenv config is intended
+	if err != nil {
+		return "", err
+	}
+	version := strings.TrimPrefix(strings.TrimSpace(string(data)), "v")
+	if _, err := semver.NewVersion(version); err != nil {
+		return "", fmt.Errorf("tfenv default version %q is not an exact version", version)
+	}
+	return version, nil
+}
+
+// importCmd represents the import command
+var importCmd = &cobra.Command{
+	Use:   "import [path]",
+	Short: "Import Terraform versions installed by tfenv, tfswitch or in a directory",
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		switch importFrom {
+		case importFromTfenv, importFromTfswitch, importFromDir:
+		default:
+			FatalError("invalid --from value %q, allowed values are: tfenv, tfswitch, dir", importFrom)
+		}
+
+		if err := initConfig(); err != nil {
+			FatalError("Failed to create config: %v", err)
+		}
+		if !flagChanged(cmd, "link") {
+			importLink = getSettingString("link_mode") == linkModeHardlink
+		}
+
+		var root string
+		if len(args) == 1 {
+			root = args[0]
+		} else {
+			defaultPath, err := getDefaultImportPath(importFrom)
+			if err != nil {
+				FatalError("%v", err)
+			}
+			root = defaultPath
+		}
+
+		searchDir := root
+		if importFrom == importFromTfenv {
+			searchDir = filepath.Join(root, "versions")
+		}
+		candidates, err := discoverImportCandidates(searchDir)
+		if err != nil {
+			FatalError("%v", err)
+		}
+		if len(candidates) == 0 {
+			LogInfo("No Terraform versions found in %s", searchDir)
+			return
+		}
+
+		imported, failed := 0, 0
+		for _, candidate := range candidates {
+			if _, err := os.Stat(filepath.Join(terraformVersionPath, candidate.Version)); err == nil {
+				LogInfo("Terraform v%s is already installed, skipping", candidate.Version)
+				continue
+			}
+			if err := importVersion(candidate, importLink); err != nil {
+				LogError("Failed to import v%s from %s: %v", candidate.Version, candidate.Binary, err)
+				failed++
+				continue
+			}
+			LogInfo("Imported Terraform v%s from %s", candidate.Version, candidate.Binary)
+			imported++
+		}
+		LogInfo("Imported %d version(s)", imported)
+
+		if importFrom == importFromTfenv {
+			defaultVersion, err := readTfenvDefaultVersion(root)
+			switch {
+			case os.IsNotExist(err):
+			case err != nil:
+				LogWarn("%v", err)
+			default:
+				if _, statErr := os.Stat(filepath.Join(terraformVersionPath, defaultVersion)); statErr == nil {
+					_ = useVersion(defaultVersion)
+				} else {
+					LogWarn("tfenv default version v%s was not imported", defaultVersion)
+				}
+			}
+		}
+
+		if failed > 0 {
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(importCmd)
+	importCmd.Flags().StringVarP(&importFrom, "from", "", "", "Source to import from: tfenv, tfswitch or dir")
+	importCmd.Flags().BoolVarP(&importLink, "link", "", false, "Hard-link binaries instead of copying, falls back to copying across filesystems")
+	_ = importCmd.MarkFlagRequired("from")
+}
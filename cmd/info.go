@@ -0,0 +1,247 @@
+/*
+Copyright © 2025 Denys Makeienko <denys.makeienko@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/spf13/cobra"
+)
+
+// versionDetails is everything known about a version, combining remote metadata and local install state
+type versionDetails struct {
+	Version             string           `json:"version" yaml:"version"`
+	ReleaseDate         string           `json:"release_date,omitempty" yaml:"release_date,omitempty"`
+	Prerelease          bool             `json:"prerelease" yaml:"prerelease"`
+	Builds              []string         `json:"builds" yaml:"builds"`
+	Platform            string           `json:"platform" yaml:"platform"`
+	ArchiveURL          string           `json:"archive_url,omitempty" yaml:"archive_url,omitempty"`
+	ArchiveSize         int64            `json:"archive_size,omitempty" yaml:"archive_size,omitempty"`
+	ArchiveSHA256       string           `json:"archive_sha256,omitempty" yaml:"archive_sha256,omitempty"`
+	Installed           bool             `json:"installed" yaml:"installed"`
+	InstallDate         string           `json:"install_date,omitempty" yaml:"install_date,omitempty"`
+	Path                string           `json:"path,omitempty" yaml:"path,omitempty"`
+	Manifest            *installManifest `json:"manifest,omitempty" yaml:"manifest,omitempty"`
+	Active              bool             `json:"active" yaml:"active"`
+	Constraint          string           `json:"constraint,omitempty" yaml:"constraint,omitempty"`
+	SatisfiesConstraint *bool            `json:"satisfies_constraint,omitempty" yaml:"satisfies_constraint,omitempty"`
+	Support             supportStatus    `json:"support" yaml:"support"`
+}
+
+func getVersionDetails(version string) versionDetails {
+	osType, arch := getPlatform()
+	details := versionDetails{
+		Version:  version,
+		Platform: osType + "_" + arch,
+		Builds:   []string{},
+	}
+	if v, err := semver.NewVersion(version); err == nil {
+		details.Prerelease = v.Prerelease() != ""
+	}
+
+	// Remote metadata
+	release, err := getTerraformRelease(version)
+	if err != nil {
+		LogWarn("Failed to get release metadata: %v", err)
+	} else {
+		details.ReleaseDate = release.TimestampCreated.Format(time.RFC3339)
+		details.Prerelease = release.IsPrerelease
+		for _, build := range release.Builds {
+			platform := build.OS + "_" + build.Arch
+			details.Builds = append(details.Builds, platform)
+			if platform == details.Platform {
+				details.ArchiveURL = build.URL
+			}
+		}
+		sort.Strings(details.Builds)
+	}
+
+	if details.ArchiveURL != "" {
+		if size, err := getContentLength(details.ArchiveURL); err != nil {
+			LogWarn("Failed to get archive size: %v", err)
+		} else {
+			details.ArchiveSize = size
+		}
+		if sha256, err := getArchiveSHA256(version, getArchiveName(version, osType, arch)); err != nil {
+			LogWarn("Failed to get archive checksum: %v", err)
+		} else {
+			details.ArchiveSHA256 = sha256
+		}
+	}
+
+	// Local install state
+	versionDir := filepath.Join(terraformVersionPath, version)
+	if stat, err := os.Stat(filepath.Join(versionDir, "terraform")); err == nil {
+		details.Installed = true
+		details.Path = filepath.Join(versionDir, "terraform")
+		if manifest, err := readManifest(version); err == nil {
+			details.Manifest = manifest
+			details.InstallDate = manifest.InstalledAt.Format(time.RFC3339)
+		} else if dirStat, err := os.Stat(versionDir); err == nil {
+			details.InstallDate = dirStat.ModTime().Format(time.RFC3339)
+		} else {
+			details.InstallDate = stat.ModTime().Format(time.RFC3339)
+		}
+	}
+	if currentVersion, err := getCurrentTerraformVersion(); err == nil {
+		details.Active = currentVersion == version
+	}
+
+	details.Support = getSupportStatus(version)
+
+	// Current directory required_version
+	if constraint, err := getTerraformVersionConstraint(); err == nil {
+		details.Constraint = constraint
+		if constraints, err := semver.NewConstraint(constraint); err == nil {
+			if v, err := semver.NewVersion(version); err == nil {
+				satisfies := constraints.Check(v)
+				details.SatisfiesConstraint = &satisfies
+			}
+		}
+	}
+
+	return details
+}
+
+// formatBytes formats the size in human-readable units
+func formatBytes(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
+
+func printVersionDetails(details versionDetails) {
+	valueOrDash := func(value string) string {
+		if value == "" {
+			return "-"
+		}
+		return value
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Version:\t%s\n", details.Version)
+	fmt.Fprintf(w, "Release date:\t%s\n", valueOrDash(details.ReleaseDate))
+	fmt.Fprintf(w, "Prerelease:\t%t\n", details.Prerelease)
+	fmt.Fprintf(w, "Builds:\t%s\n", valueOrDash(strings.Join(details.Builds, ", ")))
+	fmt.Fprintf(w, "Platform:\t%s\n", details.Platform)
+	if details.ArchiveSize > 0 {
+		fmt.Fprintf(w, "Archive size:\t%s\n", formatBytes(details.ArchiveSize))
+	} else {
+		fmt.Fprintf(w, "Archive size:\t-\n")
+	}
+	fmt.Fprintf(w, "Archive SHA256:\t%s\n", valueOrDash(details.ArchiveSHA256))
+	fmt.Fprintf(w, "Installed:\t%t\n", details.Installed)
+	if details.Installed {
+		fmt.Fprintf(w, "Install date:\t%s\n", details.InstallDate)
+		fmt.Fprintf(w, "Path:\t%s\n", details.Path)
+		if details.Manifest != nil {
+			fmt.Fprintf(w, "Installed from:\t%s\n", details.Manifest.SourceURL)
+			fmt.Fprintf(w, "Binary SHA256:\t%s\n", details.Manifest.BinarySHA256)
+			fmt.Fprintf(w, "Installed by:\ttfenvgo %s\n", details.Manifest.TfenvgoVersion)
+		}
+	}
+	fmt.Fprintf(w, "Active:\t%t\n", details.Active)
+	if details.Support.EOL != "" {
+		fmt.Fprintf(w, "Support status:\t%s (EOL %s)\n", details.Support.Status, details.Support.EOL)
+	} else {
+		fmt.Fprintf(w, "Support status:\t%s\n", details.Support.Status)
+	}
+	for _, bad := range details.Support.KnownBad {
+		fmt.Fprintf(w, "Advisory:\t%s %s\n", bad.Reason, bad.Advisory)
+	}
+	if details.SatisfiesConstraint != nil {
+		fmt.Fprintf(w, "Satisfies required_version:\t%t (%s)\n", *details.SatisfiesConstraint, details.Constraint)
+	} else {
+		fmt.Fprintf(w, "Satisfies required_version:\t-\n")
+	}
+	_ = w.Flush()
+}
+
+// infoCmd represents the info command
+var infoCmd = &cobra.Command{
+	Use:   "info <version>",
+	Short: "Show release metadata and local install state of a Terraform version",
+	Args:  cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := setupOutput(); err != nil {
+			FatalError("%v", err)
+		}
+
+		version := args[0]
+		var versionRegex *regexp.Regexp
+		if len(args) == 2 && args[0] == latestArg {
+			regex, err := regexp.Compile(args[1])
+			if err != nil {
+				FatalError("Invalid regex %q: %v", args[1], err)
+			}
+			versionRegex = regex
+		}
+
+		allowedVersions := map[string]bool{
+			latestArg:        true,
+			latestAllowedArg: true,
+			minRequiredArg:   true,
+		}
+
+		if validateArg(version, allowedVersions) != nil {
+			os.Exit(1)
+		}
+
+		version, err := resolveVersion(version, versionRegex, "remote")
+		if err != nil {
+			FatalError("%v", err)
+		}
+
+		details := getVersionDetails(version)
+		switch outputFormat {
+		case outputJSON, outputYAML:
+			if err := printStructured(os.Stdout, details); err != nil {
+				FatalError("%v", err)
+			}
+		case outputPlain:
+			fmt.Println(details.Version)
+		default:
+			printVersionDetails(details)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(infoCmd)
+	infoCmd.Flags().StringVarP(&outputFormat, "output", "o", outputText, "Output format: text, plain, json or yaml")
+	infoCmd.Flags().BoolVarP(&PreReleaseVersionsIncluded, "include-prerelease", "", false, "Include pre-release versions")
+}
@@ -24,7 +24,9 @@ package cmd
 import (
 	"archive/zip"
 	"context"
+	"crypto/sha256"
 	"crypto/tls"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"math"
@@ -178,12 +180,40 @@ func unarchiveZip(archivePath, version string) error {
 	return nil
 }
 
-func downloadTerraform(version string) error {
-	osType := getEnv(archEnvKey, defaultOSType)
-	arch := getEnv(osTypeEnvKey, defaultArch)
-	terraformDownloadURL := terraformReleasesURL + "/" + version + "/terraform_" + version + "_" + osType + "_" + arch + ".zip"
-	LogInfo("Downloading %s", terraformDownloadURL)
+// fileSHA256 returns the hex encoded SHA256 checksum of the file
+func fileSHA256(path string) (string, error) {
+	file, err := os.Open(path) // #nosec G304 -- path is controlled by tfenvgo
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// verifyArchiveChecksum compares the downloaded archive with the checksum published in SHA256SUMS
+func verifyArchiveChecksum(archivePath, version, archiveName string) error {
+	expected, err := getArchiveSHA256(version, archiveName)
+	if err != nil {
+		return fmt.Errorf("failed to get checksum, set verification to none to skip: %w", err)
+	}
+	actual, err := fileSHA256(archivePath)
+	if err != nil {
+		return fmt.Errorf("failed to compute checksum: %w", err)
+	}
+	if !strings.EqualFold(actual, expected) {
+		return fmt.Errorf("checksum mismatch for %s: expected %s, got %s", archiveName, expected, actual)
+	}
+	LogInfo("Verified checksum of %s", archiveName)
+	return nil
+}
 
+// downloadFile downloads the URL to a temp file named after pattern and returns its path
+func downloadFile(url, pattern string) (string, error) {
 	// Create HTTP client with security configurations
 	client := &http.Client{
 		Timeout: 30 * time.Second,
@@ -198,9 +228,9 @@ func downloadTerraform(version string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
-	req, err := http.NewRequestWithContext(ctx, "GET", terraformDownloadURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return "", fmt.Errorf("failed to create request: %w", err)
 	}
 
 	// Set User-Agent header
@@ -209,22 +239,22 @@ func downloadTerraform(version string) error {
 	// Get the data
 	resp, err := client.Do(req)
 	if err != nil {
-		return fmt.Errorf("failed to download: %w", err)
+		return "", fmt.Errorf("failed to download: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("failed to download file: %s", resp.Status)
+		return "", fmt.Errorf("failed to download file: %s", resp.Status)
 	}
 
 	// Create a secure temp file
 	tempDir := os.TempDir()
-	tmpFile, err := os.CreateTemp(tempDir, "tfenvgo-*.zip")
+	tmpFile, err := os.CreateTemp(tempDir, pattern)
 	if err != nil {
-		return fmt.Errorf("failed to create temp file: %w", err)
+		return "", fmt.Errorf("failed to create temp file: %w", err)
 	}
 	filepath := tmpFile.Name()
-	// ensure file is closed and removed on errors
+	// ensure file is closed on errors
 	defer func() {
 		_ = tmpFile.Close()
 	}()
@@ -236,13 +266,39 @@ func downloadTerraform(version string) error {
 	_, err = io.CopyN(tmpFile, resp.Body, maxFileSize)
 	if err != nil && err != io.EOF {
 		_ = os.Remove(filepath)
-		return fmt.Errorf("failed to write file: %w", err)
+		return "", fmt.Errorf("failed to write file: %w", err)
+	}
+	return filepath, nil
+}
+
+func downloadTerraform(version string) error {
+	osType, arch := getPlatform()
+	archiveName := getArchiveName(version, osType, arch)
+	terraformDownloadURL := getMirrorURL() + "/" + version + "/" + archiveName
+	LogInfo("Downloading %s", terraformDownloadURL)
+
+	filepath, err := downloadFile(terraformDownloadURL, "tfenvgo-*.zip")
+	if err != nil {
+		return err
+	}
+
+	if getSettingString("verification") == verificationChecksum {
+		if err := verifyArchiveChecksum(filepath, version, archiveName); err != nil {
+			_ = os.Remove(filepath)
+			return err
+		}
+	}
+
+	archiveSHA256, err := fileSHA256(filepath)
+	if err != nil {
+		LogWarn("Failed to compute checksum of %s: %v", filepath, err)
 	}
 
 	err = unarchiveZip(filepath, version)
 	if err != nil {
 		return fmt.Errorf("failed to unarchive: %w", err)
 	}
+	recordInstallManifest(version, terraformDownloadURL, getMirrorURL(), osType, arch, archiveSHA256)
 
 	LogInfo("Removing %s", filepath)
 	if err := os.Remove(filepath); err != nil {
@@ -252,18 +308,19 @@ func downloadTerraform(version string) error {
 	return nil
 }
 
-func installTerraform(version string) {
+func installTerraform(version string) error {
 	_, err := os.Stat(filepath.Join(terraformVersionPath, version))
 	if os.IsNotExist(err) {
 		err := downloadTerraform(version)
 		if err != nil {
 			LogError("error downloading: %v", err)
-			return
+			return err
 		}
 		LogInfo("Terraform v%s has been installed", version)
 	} else {
 		LogWarn("Terraform v%s is already installed.", version)
 	}
+	return nil
 }
 
 // installCmd represents the install command
@@ -272,6 +329,16 @@ var installCmd = &cobra.Command{
 	Short: "Install a specific Terraform version",
 	Args:  cobra.MaximumNArgs(2),
 	Run: func(cmd *cobra.Command, args []string) {
+		if interactive {
+			version, err := pickVersionInteractively()
+			if err != nil {
+				LogError("%v", err)
+				return
+			}
+			_ = installTerraform(version)
+			return
+		}
+
 		var version string
 		var versionRegex *regexp.Regexp
 		versionFromFile, _ := readVersionFromFile()
@@ -297,46 +364,18 @@ var installCmd = &cobra.Command{
 			return
 		}
 
-		switch {
-		case (version == latestArg && versionRegex == nil):
-			versions, err := getRemoteTerraformVersions(PreReleaseVersionsIncluded)
-			if err != nil {
-				LogError("failed to get latest version: %v", err)
-				return
-			}
-			if len(versions) == 0 {
-				LogError("no remote versions found")
-				return
-			}
-			version = versions[0]
-		case (version == minRequiredArg):
-			minRequiredVersion, err := getMinRequired("remote")
-			if err != nil {
-				LogError("Failed to get minimum required version: %v", err)
-				return
-			}
-			version = minRequiredVersion
-		case (version == latestAllowedArg):
-			latestAllowedVersion, err := getLatestAllowed("remote", "")
-			if err != nil {
-				LogError("Failed to get latest allowed version: %v", err)
-				return
-			}
-			version = latestAllowedVersion
-		case (version == latestArg && versionRegex != nil):
-			latestRegexVersion, err := getLatestAllowed("remote", versionRegex.String())
-			if err != nil {
-				LogError("Failed to get latest allowed version: %v", err)
-				return
-			}
-			version = latestRegexVersion
+		version, err := resolveVersion(version, versionRegex, "remote")
+		if err != nil {
+			LogError("%v", err)
+			return
 		}
-		installTerraform(version)
+		_ = installTerraform(version)
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(installCmd)
 	installCmd.Flags().BoolVarP(&PreReleaseVersionsIncluded, "include-prerelease", "", false, "Include pre-release versions")
+	installCmd.Flags().BoolVarP(&interactive, "interactive", "i", false, "Pick the version interactively")
 
 }
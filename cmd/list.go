@@ -22,10 +22,15 @@ THE SOFTWARE.
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"regexp"
 	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/spf13/cobra"
@@ -68,26 +73,150 @@ func getLocalTerraformVersions(preReleaseVersionsIncluded bool) ([]string, error
 	return versionStrings, nil
 }
 
+var (
+	listLong bool
+	listSort string
+)
+
+// localVersionDetails are on-disk size, install date and usage of an installed version
+type localVersionDetails struct {
+	Size        int64
+	InstallDate time.Time
+	LastUsed    time.Time
+	Projects    []string
+	Manifest    *installManifest
+}
+
+func getLocalVersionDetails(version string, usage *usageRecord) localVersionDetails {
+	versionDir := filepath.Join(terraformVersionPath, version)
+	details := localVersionDetails{
+		LastUsed: getLastUsed(version, usage),
+	}
+	if size, err := getDirSize(versionDir); err == nil {
+		details.Size = size
+	}
+	if manifest, err := readManifest(version); err == nil {
+		details.Manifest = manifest
+		details.InstallDate = manifest.InstalledAt
+	} else if stat, err := os.Stat(versionDir); err == nil {
+		details.InstallDate = stat.ModTime()
+	}
+	if record, ok := usage.Versions[version]; ok {
+		details.Projects = record.Projects
+	}
+	return details
+}
+
+// sortLocalVersions sorts versions in place by the given key, versions are expected in descending order
+func sortLocalVersions(versions []string, details map[string]localVersionDetails, key string) error {
+	var less func(a, b localVersionDetails) bool
+	switch key {
+	case "version":
+		return nil
+	case "size":
+		less = func(a, b localVersionDetails) bool { return a.Size > b.Size }
+	case "installed":
+		less = func(a, b localVersionDetails) bool { return a.InstallDate.After(b.InstallDate) }
+	case "used":
+		less = func(a, b localVersionDetails) bool { return a.LastUsed.After(b.LastUsed) }
+	default:
+		return fmt.Errorf("invalid sort key %q, allowed values are: version, size, installed, used", key)
+	}
+	sort.SliceStable(versions, func(i, j int) bool {
+		return less(details[versions[i]], details[versions[j]])
+	})
+	return nil
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return t.Local().Format("2006-01-02 15:04")
+}
+
+func printLongList(versions []string, details map[string]localVersionDetails, currentTerraformVersion string) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "     VERSION\tSIZE\tINSTALLED\tLAST USED\tORIGIN\tSUPPORT\tPROJECTS")
+	for _, v := range versions {
+		d := details[v]
+		projects := "-"
+		if len(d.Projects) > 0 {
+			projects = strings.Join(d.Projects, ", ")
+		}
+		origin := "-"
+		if d.Manifest != nil {
+			origin = d.Manifest.origin()
+		}
+		marker := "     "
+		if v == currentTerraformVersion {
+			marker = "---> "
+		}
+		fmt.Fprintf(w, "%s%s\t%s\t%s\t%s\t%s\t%s\t%s\n", marker, v, formatBytes(d.Size), formatTime(d.InstallDate), formatTime(d.LastUsed), origin, formatSupportStatus(getSupportStatus(v)), projects)
+	}
+	_ = w.Flush()
+}
+
 var listCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List all installed Terraform versions",
 	Run: func(cmd *cobra.Command, args []string) {
+		if err := setupOutput(); err != nil {
+			FatalError("%v", err)
+		}
+
 		versions, err := getLocalTerraformVersions(PreReleaseVersionsIncluded)
 		if err != nil {
-			fmt.Println("failed to list installed versions: %w", err)
-			return
+			FatalError("failed to list installed versions: %v", err)
 		}
 		currentTerraformVersion, err := getCurrentTerraformVersion()
+		if errors.Is(err, errDanglingActiveLink) {
+			LogWarn("%v", err)
+		}
+
+		usage, err := readUsage()
 		if err != nil {
+			LogWarn("%v", err)
+		}
+		details := make(map[string]localVersionDetails, len(versions))
+		for _, v := range versions {
+			details[v] = getLocalVersionDetails(v, usage)
+		}
+		if err := sortLocalVersions(versions, details, listSort); err != nil {
+			FatalError("%v", err)
+		}
+
+		if isMachineOutput() {
+			var infos []versionInfo
+			for _, v := range versions {
+				info := newVersionInfo(v, sourceLocal, currentTerraformVersion)
+				d := details[v]
+				info.Size = d.Size
+				if !d.InstallDate.IsZero() {
+					info.InstallDate = d.InstallDate.Format(time.RFC3339)
+				}
+				if !d.LastUsed.IsZero() {
+					info.LastUsed = d.LastUsed.Format(time.RFC3339)
+				}
+				info.Projects = d.Projects
+				infos = append(infos, info)
+			}
+			if err := printVersionInfos(infos); err != nil {
+				FatalError("%v", err)
+			}
 			return
 		}
 
 		fmt.Println(Green + "Installed Terraform versions:" + Reset)
+		if listLong {
+			printLongList(versions, details, currentTerraformVersion)
+			return
+		}
 		for _, v := range versions {
 			if v == currentTerraformVersion {
-				fmt.Println(Green + "---> " + v + " (set by " + terraformBinPath + ")" + Reset)
+				fmt.Println(Green + "---> " + v + " (set by " + terraformBinPath + ")" + Reset + supportAnnotation(v))
 			} else {
-				fmt.Println("     " + Gray + v + Reset)
+				fmt.Println("     " + Gray + v + Reset + supportAnnotation(v))
 			}
 		}
 	},
@@ -96,4 +225,7 @@ var listCmd = &cobra.Command{
 func init() {
 	rootCmd.AddCommand(listCmd)
 	listCmd.Flags().BoolVarP(&PreReleaseVersionsIncluded, "include-prerelease", "", false, "Include pre-release versions")
+	listCmd.Flags().StringVarP(&outputFormat, "output", "o", outputText, "Output format: text, plain, json or yaml")
+	listCmd.Flags().BoolVarP(&listLong, "long", "l", false, "Show size, install date, last used date and projects of each version")
+	listCmd.Flags().StringVarP(&listSort, "sort", "s", "version", "Sort by: version, size, installed or used")
 }
@@ -29,11 +29,34 @@ import (
 	"regexp"
 	"time"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/spf13/cobra"
 	"golang.org/x/net/html"
 )
 
 func getRemoteTerraformVersions(preReleaseVersionsIncluded bool) ([]string, error) {
+	allVersions, err := getRemoteVersionIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	if preReleaseVersionsIncluded {
+		return allVersions, nil
+	}
+
+	stableVersionRegex := regexp.MustCompile(`^[0-9]+\.[0-9]+\.[0-9]+$`)
+	var versions []string
+	for _, v := range allVersions {
+		if stableVersionRegex.MatchString(v) {
+			versions = append(versions, v)
+		}
+	}
+	return versions, nil
+}
+
+// fetchRemoteTerraformVersions fetches and parses the releases page. If cache is not nil, the request is
+// conditional and nil is returned with no error when the page is not modified.
+func fetchRemoteTerraformVersions(cache *remoteVersionCache) (*remoteVersionCache, error) {
 	// Create HTTP client with security configurations
 	client := &http.Client{
 		Timeout: 15 * time.Second,
@@ -48,7 +71,7 @@ func getRemoteTerraformVersions(preReleaseVersionsIncluded bool) ([]string, erro
 	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
 	defer cancel()
 
-	req, err := http.NewRequestWithContext(ctx, "GET", terraformReleasesURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", getMirrorURL(), nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -56,26 +79,36 @@ func getRemoteTerraformVersions(preReleaseVersionsIncluded bool) ([]string, erro
 	// Set User-Agent header
 	req.Header.Set("User-Agent", "tfenvgo/"+Version)
 
+	// Revalidate cached list
+	if cache != nil {
+		if cache.ETag != "" {
+			req.Header.Set("If-None-Match", cache.ETag)
+		}
+		if cache.LastModified != "" {
+			req.Header.Set("If-Modified-Since", cache.LastModified)
+		}
+	}
+
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch releases: %w", err)
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotModified && cache != nil {
+		return nil, nil
+	}
+
 	if resp.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf("failed to fetch page, status code: %d", resp.StatusCode)
 	}
 
-	var versions []string
-	var versionRegex *regexp.Regexp
-
-	stableVersionRegex := regexp.MustCompile(`^/terraform/([0-9]+\.[0-9]+\.[0-9]+)/$`)
-	preReleaseVersionRegex := regexp.MustCompile(`^/terraform/(\d+\.\d+\.\d+(-[a-z]+\d+)?)\/$`)
-
-	if preReleaseVersionsIncluded {
-		versionRegex = preReleaseVersionRegex
-	} else {
-		versionRegex = stableVersionRegex
+	// Keep all versions including prereleases, they are filtered on read
+	// Mirrors may use relative links, so only the last path segment is matched
+	versionRegex := regexp.MustCompile(`^(?:.*/)?(\d+\.\d+\.\d+(-[a-z]+\d+)?)/$`)
+	result := &remoteVersionCache{
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
 	}
 
 	z := html.NewTokenizer(resp.Body)
@@ -92,7 +125,7 @@ func getRemoteTerraformVersions(preReleaseVersionsIncluded bool) ([]string, erro
 					if string(attrName) == "href" {
 						matches := versionRegex.FindStringSubmatch(string(attrValue))
 						if len(matches) >= 2 {
-							versions = append(versions, matches[1])
+							result.Versions = append(result.Versions, matches[1])
 						}
 					}
 					if !moreAttr {
@@ -103,7 +136,91 @@ func getRemoteTerraformVersions(preReleaseVersionsIncluded bool) ([]string, erro
 		}
 	}
 
-	return versions, nil
+	return result, nil
+}
+
+var (
+	listRemoteConstraint string
+	listRemoteRegex      string
+	listRemoteLimit      int
+	listRemoteLatestPer  string
+	listRemoteSince      string
+)
+
+// filterRemoteVersions applies list-remote filters to versions sorted in descending order
+func filterRemoteVersions(versions []string) ([]string, error) {
+	var constraints *semver.Constraints
+	if listRemoteConstraint != "" {
+		c, err := semver.NewConstraint(listRemoteConstraint)
+		if err != nil {
+			return nil, fmt.Errorf("invalid constraint: %w", err)
+		}
+		constraints = c
+	}
+
+	var versionRegex *regexp.Regexp
+	if listRemoteRegex != "" {
+		r, err := regexp.Compile(listRemoteRegex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regex: %w", err)
+		}
+		versionRegex = r
+	}
+
+	switch listRemoteLatestPer {
+	case "", "minor", "major":
+	default:
+		return nil, fmt.Errorf("invalid --latest-per value %q, allowed values are: minor, major", listRemoteLatestPer)
+	}
+
+	var releasedSince map[string]bool
+	if listRemoteSince != "" {
+		since, err := time.Parse(time.DateOnly, listRemoteSince)
+		if err != nil {
+			return nil, fmt.Errorf("invalid date %q, expected format is YYYY-MM-DD: %w", listRemoteSince, err)
+		}
+		releases, err := getTerraformReleases(since)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get release dates: %w", err)
+		}
+		releasedSince = make(map[string]bool, len(releases))
+		for _, release := range releases {
+			releasedSince[release.Version] = true
+		}
+	}
+
+	var filtered []string
+	seen := make(map[string]bool)
+	for _, versionStr := range versions {
+		version, err := semver.NewVersion(versionStr)
+		if err != nil {
+			continue
+		}
+		if constraints != nil && !constraints.Check(version) {
+			continue
+		}
+		if versionRegex != nil && !versionRegex.MatchString(versionStr) {
+			continue
+		}
+		if releasedSince != nil && !releasedSince[versionStr] {
+			continue
+		}
+		if listRemoteLatestPer != "" {
+			key := fmt.Sprint(version.Major())
+			if listRemoteLatestPer == "minor" {
+				key = fmt.Sprintf("%d.%d", version.Major(), version.Minor())
+			}
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+		}
+		filtered = append(filtered, versionStr)
+		if listRemoteLimit > 0 && len(filtered) >= listRemoteLimit {
+			break
+		}
+	}
+	return filtered, nil
 }
 
 // listRemoteCmd represents the listRemote command
@@ -112,14 +229,47 @@ var listRemoteCmd = &cobra.Command{
 	Short: "List all available Terraform versions",
 	Long:  "List all available Terraform versions",
 	Run: func(cmd *cobra.Command, args []string) {
+		if err := setupOutput(); err != nil {
+			FatalError("%v", err)
+		}
+
 		versions, err := getRemoteTerraformVersions(PreReleaseVersionsIncluded)
 		if err != nil {
-			LogError("Failed to get versions: %v", err)
+			FatalError("Failed to get versions: %v", err)
+		}
+		versions, err = filterRemoteVersions(versions)
+		if err != nil {
+			FatalError("%v", err)
+		}
+
+		currentTerraformVersion, _ := getCurrentTerraformVersion()
+		if isMachineOutput() {
+			var infos []versionInfo
+			for _, v := range versions {
+				infos = append(infos, newVersionInfo(v, sourceRemote, currentTerraformVersion))
+			}
+			if err := printVersionInfos(infos); err != nil {
+				FatalError("%v", err)
+			}
 			return
 		}
-		LogInfo("Available versions:")
+
+		localVersions, _ := getLocalTerraformVersions(true)
+		installedVersions := make(map[string]bool, len(localVersions))
+		for _, v := range localVersions {
+			installedVersions[v] = true
+		}
+
+		fmt.Println(Green + "Available Terraform versions:" + Reset)
 		for _, v := range versions {
-			LogInfo("%s", v)
+			switch {
+			case v == currentTerraformVersion:
+				fmt.Println(Green + "---> " + v + " (active)" + Reset + supportAnnotation(v))
+			case installedVersions[v]:
+				fmt.Println("     " + Cyan + v + " (installed)" + Reset + supportAnnotation(v))
+			default:
+				fmt.Println("     " + Gray + v + Reset + supportAnnotation(v))
+			}
 		}
 	},
 }
@@ -127,4 +277,10 @@ var listRemoteCmd = &cobra.Command{
 func init() {
 	rootCmd.AddCommand(listRemoteCmd)
 	listRemoteCmd.Flags().BoolVarP(&PreReleaseVersionsIncluded, "include-prerelease", "", false, "Include pre-release versions")
+	listRemoteCmd.Flags().StringVarP(&outputFormat, "output", "o", outputText, "Output format: text, plain, json or yaml")
+	listRemoteCmd.Flags().StringVarP(&listRemoteConstraint, "constraint", "c", "", "Show only versions satisfying the constraint, e.g. \"~> 1.6\"")
+	listRemoteCmd.Flags().StringVarP(&listRemoteRegex, "regex", "r", "", "Show only versions matching the regex")
+	listRemoteCmd.Flags().IntVarP(&listRemoteLimit, "limit", "n", 0, "Show at most N versions")
+	listRemoteCmd.Flags().StringVarP(&listRemoteLatestPer, "latest-per", "", "", "Show only the latest version per minor or major")
+	listRemoteCmd.Flags().StringVarP(&listRemoteSince, "since", "", "", "Show only versions released since the date (YYYY-MM-DD)")
 }
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"io"
 	"os"
 )
 
@@ -17,17 +18,30 @@ const (
 
 var currentLogLevel = LevelInfo
 
+// logOutput is where log messages are written to
+var logOutput io.Writer = os.Stdout
+
 // SetLogLevel sets the current logging level
 func SetLogLevel(level LogLevel) {
 	currentLogLevel = level
 }
 
+// SetLogOutput sets the writer log messages are written to
+func SetLogOutput(w io.Writer) {
+	logOutput = w
+}
+
 // logMessage outputs a message with color and level prefix
 func logMessage(level LogLevel, color, prefix, message string) {
+	logMessageTo(logOutput, level, color, prefix, message)
+}
+
+// logMessageTo outputs a message with color and level prefix to the writer
+func logMessageTo(w io.Writer, level LogLevel, color, prefix, message string) {
 	if level > currentLogLevel {
 		return
 	}
-	fmt.Printf("%s[%s]%s %s\n", color, prefix, Reset, message)
+	fmt.Fprintf(w, "%s[%s]%s %s\n", color, prefix, Reset, message)
 }
 
 // LogError logs an error message
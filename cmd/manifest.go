@@ -0,0 +1,121 @@
+/*
+Copyright © 2025 Denys Makeienko <denys.makeienko@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const manifestFilename = "manifest.json"
+
+// installManifest records where an installed version came from, written to versions/<version>/manifest.json
+type installManifest struct {
+	Version        string    `json:"version" yaml:"version"`
+	SourceURL      string    `json:"source_url" yaml:"source_url"`
+	Mirror         string    `json:"mirror,omitempty" yaml:"mirror,omitempty"`
+	OS             string    `json:"os" yaml:"os"`
+	Arch           string    `json:"arch" yaml:"arch"`
+	ArchiveSHA256  string    `json:"archive_sha256,omitempty" yaml:"archive_sha256,omitempty"`
+	BinarySHA256   string    `json:"binary_sha256" yaml:"binary_sha256"`
+	Size           int64     `json:"size" yaml:"size"`
+	InstalledAt    time.Time `json:"installed_at" yaml:"installed_at"`
+	TfenvgoVersion string    `json:"tfenvgo_version" yaml:"tfenvgo_version"`
+}
+
+// origin returns a short description of the source, the mirror host or the imported file
+func (m *installManifest) origin() string {
+	u, err := url.Parse(m.SourceURL)
+	if err != nil {
+		return m.SourceURL
+	}
+	if u.Scheme == "file" {
+		return "import"
+	}
+	return u.Host
+}
+
+// newInstallManifest fills checksum, size and install time of the installed binary
+func newInstallManifest(version, sourceURL, mirror, osType, arch, archiveSHA256 string) (*installManifest, error) {
+	binaryPath := filepath.Join(terraformVersionPath, version, "terraform")
+	stat, err := os.Stat(binaryPath)
+	if err != nil {
+		return nil, err
+	}
+	binarySHA256, err := fileSHA256(binaryPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute checksum of %s: %w", binaryPath, err)
+	}
+	return &installManifest{
+		Version:        version,
+		SourceURL:      sourceURL,
+		Mirror:         mirror,
+		OS:             osType,
+		Arch:           arch,
+		ArchiveSHA256:  archiveSHA256,
+		BinarySHA256:   binarySHA256,
+		Size:           stat.Size(),
+		InstalledAt:    time.Now().UTC(),
+		TfenvgoVersion: Version,
+	}, nil
+}
+
+func writeManifest(manifest *installManifest) error {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal manifest: %w", err)
+	}
+	path := filepath.Join(terraformVersionPath, manifest.Version, manifestFilename)
+	if err := os.WriteFile(path, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
+// readManifest returns the manifest of the installed version, versions installed by older tfenvgo releases have none
+func readManifest(version string) (*installManifest, error) {
+	path := filepath.Join(terraformVersionPath, version, manifestFilename)
+	data, err := os.ReadFile(path) // #nosec G304 -- path is controlled by tfenvgo
+	if err != nil {
+		return nil, err
+	}
+	var manifest installManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return &manifest, nil
+}
+
+// recordInstallManifest writes the manifest, failures only produce a warning as the version is usable without it
+func recordInstallManifest(version, sourceURL, mirror, osType, arch, archiveSHA256 string) {
+	manifest, err := newInstallManifest(version, sourceURL, mirror, osType, arch, archiveSHA256)
+	if err == nil {
+		err = writeManifest(manifest)
+	}
+	if err != nil {
+		LogWarn("Failed to write install manifest of v%s: %v", version, err)
+	}
+}
@@ -0,0 +1,321 @@
+/*
+Copyright © 2025 Denys Makeienko <denys.makeienko@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	matrixConstraint  string
+	matrixMinorLatest bool
+	matrixTarget      string
+	matrixParallel    int
+	matrixJUnitReport string
+)
+
+type matrixResult struct {
+	Version  string
+	Passed   bool
+	Duration time.Duration
+	Output   string
+	Err      error
+}
+
+// JUnit XML report structure
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Time     string          `xml:"time,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// selectMatrixVersions returns versions satisfying the constraint, optionally only the latest patch of each minor
+func selectMatrixVersions(target, constraint string, minorLatest bool) ([]string, error) {
+	allowedVersions, err := getAllowedVersions(target, constraint)
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []string
+	seenMinors := make(map[string]bool)
+	for _, v := range allowedVersions {
+		if minorLatest {
+			minor := fmt.Sprintf("%d.%d", v.Major(), v.Minor())
+			if seenMinors[minor] {
+				continue
+			}
+			seenMinors[minor] = true
+		}
+		versions = append(versions, v.String())
+	}
+	return versions, nil
+}
+
+// lookPathIn searches for an executable in the directories of pathEnv, exec.LookPath only uses PATH of the current process
+func lookPathIn(file, pathEnv string) (string, error) {
+	if strings.ContainsRune(file, os.PathSeparator) || strings.ContainsRune(file, '/') {
+		return file, nil
+	}
+	names := []string{file}
+	if runtime.GOOS == "windows" && filepath.Ext(file) == "" {
+		names = append(names, file+".exe")
+	}
+	for _, dir := range filepath.SplitList(pathEnv) {
+		if dir == "" {
+			dir = "."
+		}
+		for _, name := range names {
+			path := filepath.Join(dir, name)
+			stat, err := os.Stat(path)
+			if err != nil || stat.IsDir() {
+				continue
+			}
+			if runtime.GOOS != "windows" && stat.Mode().Perm()&0o111 == 0 {
+				continue
+			}
+			return path, nil
+		}
+	}
+	return "", fmt.Errorf("%s: executable file not found in $PATH", file)
+}
+
+// runMatrixCommand runs the command with the given terraform version first in PATH
+func runMatrixCommand(version string, command []string, isolated bool, stdout, stderr io.Writer) error {
+	pathEnv := filepath.Join(terraformVersionPath, version) + string(os.PathListSeparator) + os.Getenv("PATH")
+	name := filepath.Join(terraformVersionPath, version, "terraform")
+	if command[0] != "terraform" {
+		path, err := lookPathIn(command[0], pathEnv)
+		if err != nil {
+			return err
+		}
+		name = path
+	}
+
+	// #nosec G204 -- command is provided by the user on purpose
+	c := exec.Command(name, command[1:]...)
+	c.Stdout = stdout
+	c.Stderr = stderr
+	c.Stdin = nil
+	c.Env = append(os.Environ(),
+		"PATH="+pathEnv,
+		terraformVersionEnvKey+"="+version,
+	)
+
+	if isolated {
+		dataDir, err := os.MkdirTemp("", "tfenvgo-matrix-"+version+"-*")
+		if err != nil {
+			return fmt.Errorf("failed to create TF_DATA_DIR: %w", err)
+		}
+		defer func() {
+			if err := os.RemoveAll(dataDir); err != nil {
+				LogWarn("failed to remove %s: %v", dataDir, err)
+			}
+		}()
+		c.Env = append(c.Env, "TF_DATA_DIR="+dataDir)
+	}
+
+	return c.Run()
+}
+
+func writeJUnitReport(path string, command []string, results []matrixResult) error {
+	suite := junitTestSuite{
+		Name:  "tfenvgo matrix",
+		Tests: len(results),
+	}
+	var total time.Duration
+	for _, r := range results {
+		total += r.Duration
+		testCase := junitTestCase{
+			Name:      "terraform " + r.Version,
+			Classname: strings.Join(command, " "),
+			Time:      fmt.Sprintf("%.3f", r.Duration.Seconds()),
+			SystemOut: r.Output,
+		}
+		if !r.Passed {
+			suite.Failures++
+			testCase.Failure = &junitFailure{Message: r.Err.Error(), Text: r.Output}
+		}
+		suite.Cases = append(suite.Cases, testCase)
+	}
+	suite.Time = fmt.Sprintf("%.3f", total.Seconds())
+
+	out, err := xml.MarshalIndent(junitTestSuites{Suites: []junitTestSuite{suite}}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal report: %w", err)
+	}
+	out = append([]byte(xml.Header), out...)
+	if err := os.WriteFile(path, append(out, '\n'), 0o600); err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+	return nil
+}
+
+// printMatrixSummary prints the results table, cells are padded before colouring as escape codes break tabwriter alignment
+func printMatrixSummary(results []matrixResult) {
+	versionWidth := len("VERSION")
+	for _, r := range results {
+		versionWidth = max(versionWidth, len(r.Version))
+	}
+	const resultWidth = len("RESULT")
+	fmt.Printf("%-*s   %-*s   %s\n", versionWidth, "VERSION", resultWidth, "RESULT", "DURATION")
+	for _, r := range results {
+		result := Green + fmt.Sprintf("%-*s", resultWidth, "PASS") + Reset
+		if !r.Passed {
+			result = Red + fmt.Sprintf("%-*s", resultWidth, "FAIL") + Reset
+		}
+		fmt.Printf("%-*s   %s   %s\n", versionWidth, r.Version, result, r.Duration.Round(time.Millisecond))
+	}
+}
+
+// matrixCmd represents the matrix command
+var matrixCmd = &cobra.Command{
+	Use:   "matrix --constraint <constraint> -- <command> [args...]",
+	Short: "Run a command against a matrix of Terraform versions",
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if matrixTarget != "local" && matrixTarget != "remote" {
+			FatalError("Invalid target %q, allowed values are: local, remote", matrixTarget)
+		}
+		if matrixParallel < 1 {
+			matrixParallel = 1
+		}
+
+		versions, err := selectMatrixVersions(matrixTarget, matrixConstraint, matrixMinorLatest)
+		if err != nil {
+			FatalError("Failed to select versions: %v", err)
+		}
+		LogInfo("Selected versions: %s", strings.Join(versions, ", "))
+
+		for _, version := range versions {
+			if _, err := os.Stat(filepath.Join(terraformVersionPath, version, "terraform")); err == nil {
+				continue
+			}
+			if err := installTerraform(version); err != nil {
+				FatalError("Failed to install terraform v%s: %v", version, err)
+			}
+		}
+
+		isolated := matrixParallel > 1
+		results := make([]matrixResult, len(versions))
+		semaphore := make(chan struct{}, matrixParallel)
+		var wg sync.WaitGroup
+		var outputMutex, usageMutex sync.Mutex
+
+		for i, version := range versions {
+			wg.Add(1)
+			semaphore <- struct{}{}
+			go func(i int, version string) {
+				defer wg.Done()
+				defer func() { <-semaphore }()
+
+				var output bytes.Buffer
+				var stdout, stderr io.Writer = &output, &output
+				if !isolated {
+					// Stream the output when running sequentially
+					stdout = io.MultiWriter(os.Stdout, &output)
+					stderr = io.MultiWriter(os.Stderr, &output)
+					LogInfo("Running %q with terraform v%s", strings.Join(args, " "), version)
+				}
+
+				start := time.Now()
+				err := runMatrixCommand(version, args, isolated, stdout, stderr)
+				usageMutex.Lock()
+				recordVersionUsage(version, "")
+				usageMutex.Unlock()
+				results[i] = matrixResult{
+					Version:  version,
+					Passed:   err == nil,
+					Duration: time.Since(start),
+					Output:   output.String(),
+					Err:      err,
+				}
+
+				if isolated {
+					outputMutex.Lock()
+					LogInfo("Output of %q with terraform v%s:", strings.Join(args, " "), version)
+					fmt.Print(output.String())
+					outputMutex.Unlock()
+				}
+			}(i, version)
+		}
+		wg.Wait()
+
+		fmt.Println()
+		printMatrixSummary(results)
+
+		if matrixJUnitReport != "" {
+			if err := writeJUnitReport(matrixJUnitReport, args, results); err != nil {
+				LogError("Failed to write JUnit report: %v", err)
+			} else {
+				LogInfo("JUnit report written to %s", matrixJUnitReport)
+			}
+		}
+
+		for _, r := range results {
+			if !r.Passed {
+				os.Exit(1)
+			}
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(matrixCmd)
+	matrixCmd.Flags().StringVarP(&matrixConstraint, "constraint", "c", "", "Version constraint to select versions, e.g. \">= 1.3, < 2.0\"")
+	matrixCmd.Flags().BoolVarP(&matrixMinorLatest, "minor-latest", "", false, "Select only the latest patch version of each minor version")
+	matrixCmd.Flags().StringVarP(&matrixTarget, "target", "", "remote", "Select versions from local or remote list")
+	matrixCmd.Flags().IntVarP(&matrixParallel, "parallel", "p", 1, "Number of versions to run in parallel, each with an isolated TF_DATA_DIR")
+	matrixCmd.Flags().StringVarP(&matrixJUnitReport, "junit-report", "", "", "Write a JUnit XML report to the specified file")
+	_ = matrixCmd.MarkFlagRequired("constraint")
+}
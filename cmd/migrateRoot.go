@@ -0,0 +1,157 @@
+/*
+Copyright © 2025 Denys Makeienko <denys.makeienko@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// copyDir recursively copies the directory, preserving file modes and symlinks
+func copyDir(src, dst string) error {
+	return filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		switch {
+		case d.IsDir():
+			return os.MkdirAll(target, info.Mode().Perm())
+		case d.Type()&fs.ModeSymlink != 0:
+			link, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			return os.Symlink(link, target)
+		case d.Type().IsRegular():
+			return copyFile(path, target, info.Mode().Perm())
+		}
+		return nil
+	})
+}
+
+// moveDir renames the directory, falling back to copy and remove across filesystems
+func moveDir(src, dst string) error {
+	if err := os.Rename(src, dst); err == nil {
+		return nil
+	}
+	if err := copyDir(src, dst); err != nil {
+		_ = os.RemoveAll(dst)
+		return fmt.Errorf("failed to copy %s to %s: %w", src, dst, err)
+	}
+	return os.RemoveAll(src)
+}
+
+// migrateRoot moves the root directory to newRoot and re-points the active version symlink
+func migrateRoot(newRoot string) error {
+	oldRoot := rootURL
+	if newRoot == oldRoot {
+		return fmt.Errorf("%s is already the root directory", newRoot)
+	}
+	if strings.HasPrefix(newRoot, oldRoot+string(os.PathSeparator)) {
+		return fmt.Errorf("new root %s can't be inside the current root %s", newRoot, oldRoot)
+	}
+	if _, err := os.Stat(oldRoot); err != nil {
+		return fmt.Errorf("current root %s is not accessible: %w", oldRoot, err)
+	}
+	if entries, err := os.ReadDir(newRoot); err == nil {
+		if len(entries) > 0 {
+			return fmt.Errorf("%s is not empty", newRoot)
+		}
+		if err := os.Remove(newRoot); err != nil {
+			return err
+		}
+	}
+	if err := os.MkdirAll(filepath.Dir(newRoot), 0o750); err != nil {
+		return fmt.Errorf("failed to create %s: %w", filepath.Dir(newRoot), err)
+	}
+
+	activeVersion, activeErr := getCurrentTerraformVersion()
+	oldCacheDir, oldUserConfigFilePath := cacheDir, userConfigFilePath
+
+	LogInfo("Moving %s to %s", oldRoot, newRoot)
+	if err := moveDir(oldRoot, newRoot); err != nil {
+		return err
+	}
+
+	// An explicit root holds everything, so cache and config from XDG directories are moved too
+	rootFlag = newRoot
+	if err := initPaths(); err != nil {
+		return err
+	}
+	if _, err := os.Stat(oldCacheDir); err == nil && oldCacheDir != cacheDir {
+		if err := moveDir(oldCacheDir, cacheDir); err != nil {
+			LogWarn("Failed to move cache: %v", err)
+		}
+	}
+	if _, err := os.Stat(oldUserConfigFilePath); err == nil && oldUserConfigFilePath != userConfigFilePath {
+		if err := os.Rename(oldUserConfigFilePath, userConfigFilePath); err != nil {
+			LogWarn("Failed to move %s, copy it to %s manually: %v", oldUserConfigFilePath, userConfigFilePath, err)
+		}
+	}
+
+	// The symlink is absolute, so it still points to the old location
+	if activeErr == nil {
+		_ = os.Remove(currentTerraformVersionPath)
+		if err := os.Symlink(filepath.Join(terraformVersionPath, activeVersion, "terraform"), currentTerraformVersionPath); err != nil {
+			return fmt.Errorf("failed to re-point active version: %w", err)
+		}
+		LogInfo("Active version v%s now points to %s", activeVersion, terraformVersionPath)
+	}
+	return nil
+}
+
+// migrateRootCmd represents the migrate-root command
+var migrateRootCmd = &cobra.Command{
+	Use:   "migrate-root <new>",
+	Short: "Move versions and data to a new root directory",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		newRoot, err := filepath.Abs(args[0])
+		if err != nil {
+			FatalError("Invalid directory %s: %v", args[0], err)
+		}
+		if err := migrateRoot(newRoot); err != nil {
+			FatalError("Failed to migrate root: %v", err)
+		}
+		LogInfo("Migrated to %s", newRoot)
+		LogInfo("Set %s=%s and add %s to PATH instead of the old bin directory", rootEnvKey, newRoot, terraformBinPath)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(migrateRootCmd)
+}
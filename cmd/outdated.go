@@ -0,0 +1,275 @@
+/*
+Copyright © 2025 Denys Makeienko <denys.makeienko@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/spf13/cobra"
+)
+
+var outdatedRecursive bool
+
+// outdatedReport compares the version used in a directory with available updates
+type outdatedReport struct {
+	Directory     string `json:"directory" yaml:"directory"`
+	Current       string `json:"current" yaml:"current"`
+	Source        string `json:"source" yaml:"source"`
+	Constraint    string `json:"constraint,omitempty" yaml:"constraint,omitempty"`
+	LatestPatch   string `json:"latest_patch,omitempty" yaml:"latest_patch,omitempty"`
+	LatestAllowed string `json:"latest_allowed,omitempty" yaml:"latest_allowed,omitempty"`
+	Latest        string `json:"latest,omitempty" yaml:"latest,omitempty"`
+	Outdated      bool   `json:"outdated" yaml:"outdated"`
+}
+
+// getDirectoryCurrentVersion returns the version requested by TFENVGO_TERRAFORM_VERSION, pinned in the
+// directory or the active version, in the same order as which and use
+func getDirectoryCurrentVersion(dir string) (string, string, error) {
+	if version, source, err := getProjectVersionIn(dir); err == nil {
+		aliasVersion, _, isAlias, err := expandAlias(version)
+		if err != nil {
+			return "", "", err
+		}
+		if !isAlias {
+			return version, source, nil
+		}
+		if _, err := semver.NewVersion(aliasVersion); err != nil {
+			return "", "", fmt.Errorf("alias %s does not point to an exact version", version)
+		}
+		return aliasVersion, source, nil
+	}
+	version, err := getCurrentTerraformVersion()
+	if err != nil {
+		return "", "", err
+	}
+	return version, sourceActive, nil
+}
+
+// isTerraformDirectory reports whether the directory pins a version or declares required_version
+func isTerraformDirectory(dir string) bool {
+	if _, err := os.Stat(filepath.Join(dir, terraformVersionFilename)); err == nil {
+		return true
+	}
+	_, err := getTerraformVersionConstraintIn(dir)
+	return err == nil
+}
+
+// findTerraformDirectories walks the tree and returns directories with Terraform version requirements
+func findTerraformDirectories(root string) ([]string, error) {
+	var dirs []string
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		// Skip hidden directories like .git and .terraform
+		if path != root && strings.HasPrefix(d.Name(), ".") {
+			return filepath.SkipDir
+		}
+		if isTerraformDirectory(path) {
+			dirs = append(dirs, path)
+		}
+		return nil
+	})
+	return dirs, err
+}
+
+func getOutdatedReport(dir string, remoteVersions []string) (outdatedReport, error) {
+	report := outdatedReport{Directory: dir}
+
+	current, source, err := getDirectoryCurrentVersion(dir)
+	if err != nil {
+		return report, fmt.Errorf("failed to get current version: %w", err)
+	}
+	report.Current, report.Source = current, source
+	currentVersion, err := semver.NewVersion(current)
+	if err != nil {
+		return report, fmt.Errorf("invalid current version %s: %w", current, err)
+	}
+
+	newest := func(constraint string) string {
+		constraints, err := semver.NewConstraint(constraint)
+		if err != nil {
+			LogWarn("Invalid constraint %s in %s: %v", constraint, dir, err)
+			return ""
+		}
+		versions, err := filterAllowedVersions(remoteVersions, constraints)
+		if err != nil {
+			return ""
+		}
+		return versions[0].String()
+	}
+
+	report.LatestPatch = newest(fmt.Sprintf("~%d.%d.0", currentVersion.Major(), currentVersion.Minor()))
+	if constraint, err := getTerraformVersionConstraintIn(dir); err == nil {
+		report.Constraint = constraint
+		report.LatestAllowed = newest(constraint)
+	}
+	report.Latest = newest(">= 0.0.0")
+
+	// Only an update the directory can actually take counts, releases outside required_version do not
+	candidate := report.Latest
+	if report.Constraint != "" {
+		candidate = report.LatestAllowed
+	}
+	if candidateVersion, err := semver.NewVersion(candidate); err == nil && candidateVersion.GreaterThan(currentVersion) {
+		report.Outdated = true
+	}
+
+	return report, nil
+}
+
+func printOutdatedReports(w io.Writer, reports []outdatedReport) {
+	header := []string{"DIRECTORY", "CURRENT", "LATEST PATCH", "LATEST ALLOWED", "LATEST"}
+	widths := make([]int, len(header))
+	for i, title := range header {
+		widths[i] = len(title)
+	}
+	rows := make([][]string, 0, len(reports))
+	for _, r := range reports {
+		row := []string{r.Directory, fmt.Sprintf("%s (%s)", r.Current, r.Source), r.LatestPatch, r.LatestAllowed, r.Latest}
+		for i, cell := range row {
+			if cell == "" {
+				row[i] = "-"
+			}
+			widths[i] = max(widths[i], len(row[i]))
+		}
+		rows = append(rows, row)
+	}
+
+	// Cells are padded before they are coloured, escape sequences would break the alignment otherwise
+	printRow := func(cells []string, color func(column int, cell string) string) {
+		for i, cell := range cells {
+			if i < len(cells)-1 {
+				fmt.Fprintf(w, "%s   ", color(i, fmt.Sprintf("%-*s", widths[i], cell)))
+			} else {
+				fmt.Fprintln(w, color(i, cell))
+			}
+		}
+	}
+	printRow(header, func(_ int, cell string) string { return cell })
+	for n, row := range rows {
+		current := reports[n].Current
+		printRow(row, func(column int, cell string) string {
+			candidate := strings.TrimSpace(cell)
+			switch {
+			case column < 2 || candidate == "-":
+				return cell
+			case candidate != current:
+				return Yellow + cell + Reset
+			default:
+				return Gray + cell + Reset
+			}
+		})
+	}
+}
+
+// outdatedCmd represents the outdated command
+var outdatedCmd = &cobra.Command{
+	Use:   "outdated [directory...]",
+	Short: "Report available Terraform updates for the current directory",
+	Long: `Compare the requested or active Terraform version with the newest patch in the same minor,
+the newest version allowed by required_version and the absolute latest version.
+Exits with code 1 when a newer version allowed by required_version exists, or any newer
+version when required_version is not declared.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := setupOutput(); err != nil {
+			FatalError("%v", err)
+		}
+
+		roots := args
+		if len(roots) == 0 {
+			cwd, err := os.Getwd()
+			if err != nil {
+				FatalError("Error getting current directory: %v", err)
+			}
+			roots = []string{cwd}
+		}
+
+		var dirs []string
+		for _, root := range roots {
+			if !outdatedRecursive {
+				dirs = append(dirs, root)
+				continue
+			}
+			found, err := findTerraformDirectories(root)
+			if err != nil {
+				FatalError("Failed to scan %s: %v", root, err)
+			}
+			dirs = append(dirs, found...)
+		}
+
+		remoteVersions, err := getRemoteTerraformVersions(PreReleaseVersionsIncluded)
+		if err != nil {
+			FatalError("Failed to get remote versions: %v", err)
+		}
+
+		var reports []outdatedReport
+		outdated := false
+		for _, dir := range dirs {
+			report, err := getOutdatedReport(dir, remoteVersions)
+			if err != nil {
+				LogWarn("Skipping %s: %v", dir, err)
+				continue
+			}
+			outdated = outdated || report.Outdated
+			reports = append(reports, report)
+		}
+
+		switch outputFormat {
+		case outputJSON, outputYAML:
+			if reports == nil {
+				reports = []outdatedReport{}
+			}
+			if err := printStructured(os.Stdout, reports); err != nil {
+				FatalError("%v", err)
+			}
+		case outputPlain:
+			for _, r := range reports {
+				if r.Outdated {
+					fmt.Println(r.Directory)
+				}
+			}
+		default:
+			printOutdatedReports(os.Stdout, reports)
+		}
+
+		if outdated {
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(outdatedCmd)
+	outdatedCmd.Flags().BoolVarP(&outdatedRecursive, "recursive", "r", false, "Scan directories recursively for .terraform-version and required_version")
+	outdatedCmd.Flags().StringVarP(&outputFormat, "output", "o", outputText, "Output format: text, plain, json or yaml")
+	outdatedCmd.Flags().BoolVarP(&PreReleaseVersionsIncluded, "include-prerelease", "", false, "Include pre-release versions")
+}
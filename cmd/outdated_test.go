@@ -0,0 +1,72 @@
+/*
+Copyright © 2025 Denys Makeienko <denys.makeienko@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestPrintOutdatedReports(t *testing.T) {
+	reports := []outdatedReport{
+		{Directory: "infra/network", Current: "1.5.7", Source: sourceFile, LatestPatch: "1.5.7", Latest: "1.13.1"},
+		{Directory: "app", Current: "1.6.0", Source: sourceActive, Constraint: "~> 1.6.0", LatestPatch: "1.6.6", LatestAllowed: "1.6.6", Latest: "1.13.1"},
+	}
+	want := [][]string{
+		{"DIRECTORY", "CURRENT", "LATEST PATCH", "LATEST ALLOWED", "LATEST"},
+		{"infra/network", "1.5.7 (file)", "1.5.7", "-", "1.13.1"},
+		{"app", "1.6.0 (active)", "1.6.6", "1.6.6", "1.13.1"},
+	}
+
+	var buf bytes.Buffer
+	printOutdatedReports(&buf, reports)
+	if !strings.Contains(buf.String(), Yellow+"1.13.1") || !strings.Contains(buf.String(), Gray+"1.5.7") {
+		t.Fatalf("versions are not highlighted:\n%q", buf.String())
+	}
+
+	ansi := regexp.MustCompile(`\x1b\[[0-9;]*m`)
+	lines := strings.Split(strings.TrimSuffix(ansi.ReplaceAllString(buf.String(), ""), "\n"), "\n")
+	if len(lines) != len(want) {
+		t.Fatalf("got %d lines, want %d:\n%s", len(lines), len(want), strings.Join(lines, "\n"))
+	}
+
+	// Every cell starts at the offset of its column title
+	offsets := make([]int, len(want[0]))
+	for i, title := range want[0] {
+		if i > 0 {
+			offsets[i] = offsets[i-1] + len(want[0][i-1])
+		}
+		offsets[i] += strings.Index(lines[0][offsets[i]:], title)
+	}
+	for n, line := range lines {
+		for i, cell := range want[n] {
+			if !strings.HasPrefix(line[offsets[i]:], cell) {
+				t.Errorf("line %d column %d: got %q at offset %d, want %q\n%s", n, i, line[offsets[i]:], offsets[i], cell, strings.Join(lines, "\n"))
+			}
+			if offsets[i] > 0 && line[offsets[i]-1] != ' ' {
+				t.Errorf("line %d column %d: cell at offset %d is not separated from the previous one", n, i, offsets[i])
+			}
+		}
+	}
+}
@@ -0,0 +1,150 @@
+/*
+Copyright © 2025 Denys Makeienko <denys.makeienko@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"gopkg.in/yaml.v3"
+)
+
+// Output formats
+const (
+	outputText  = "text"
+	outputPlain = "plain"
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+)
+
+// Version sources in machine-readable output
+const (
+	sourceLocal  = "local"
+	sourceRemote = "remote"
+)
+
+// flags
+var outputFormat string
+
+// versionInfo is the stable schema of a version in machine-readable output
+type versionInfo struct {
+	Version    string        `json:"version" yaml:"version"`
+	Installed  bool          `json:"installed" yaml:"installed"`
+	Active     bool          `json:"active" yaml:"active"`
+	Path       string        `json:"path" yaml:"path"`
+	Prerelease bool          `json:"prerelease" yaml:"prerelease"`
+	Source     string        `json:"source" yaml:"source"`
+	Support    supportStatus `json:"support" yaml:"support"`
+
+	// Install manifest, missing for versions installed by older tfenvgo releases
+	Manifest *installManifest `json:"manifest,omitempty" yaml:"manifest,omitempty"`
+
+	// Local details, filled only for installed versions in list
+	Size        int64    `json:"size,omitempty" yaml:"size,omitempty"`
+	InstallDate string   `json:"install_date,omitempty" yaml:"install_date,omitempty"`
+	LastUsed    string   `json:"last_used,omitempty" yaml:"last_used,omitempty"`
+	Projects    []string `json:"projects,omitempty" yaml:"projects,omitempty"`
+}
+
+func newVersionInfo(version, source, activeVersion string) versionInfo {
+	info := versionInfo{
+		Version: version,
+		Active:  version == activeVersion,
+		Source:  source,
+	}
+	if v, err := semver.NewVersion(version); err == nil {
+		info.Prerelease = v.Prerelease() != ""
+	}
+	info.Support = getSupportStatus(version)
+	binaryPath := filepath.Join(terraformVersionPath, version, "terraform")
+	if _, err := os.Stat(binaryPath); err == nil {
+		info.Installed = true
+		info.Path = binaryPath
+		if manifest, err := readManifest(version); err == nil {
+			info.Manifest = manifest
+		}
+	}
+	return info
+}
+
+// isMachineOutput reports whether the output format is meant to be consumed by tools
+func isMachineOutput() bool {
+	return outputFormat != outputText
+}
+
+// setupOutput validates the output format and redirects logs to stderr for machine-readable formats
+func setupOutput() error {
+	return setupOutputFormats(outputText, outputPlain, outputJSON, outputYAML)
+}
+
+// setupOutputFormats is setupOutput for commands supporting only some of the output formats
+func setupOutputFormats(formats ...string) error {
+	if !containsString(formats, outputFormat) {
+		return fmt.Errorf("invalid output format %q, allowed values are: %s", outputFormat, strings.Join(formats, ", "))
+	}
+	if isMachineOutput() {
+		SetLogOutput(os.Stderr)
+	}
+	return nil
+}
+
+// printStructured prints the value in JSON or YAML format
+func printStructured(w io.Writer, v interface{}) error {
+	switch outputFormat {
+	case outputJSON:
+		// Constraints like ~> 1.6.0 are printed as is instead of \u003e
+		encoder := json.NewEncoder(w)
+		encoder.SetEscapeHTML(false)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(v); err != nil {
+			return fmt.Errorf("failed to marshal output: %w", err)
+		}
+		return nil
+	case outputYAML:
+		encoder := yaml.NewEncoder(w)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(v); err != nil {
+			return fmt.Errorf("failed to marshal output: %w", err)
+		}
+		return encoder.Close()
+	}
+	return fmt.Errorf("format %q is not structured", outputFormat)
+}
+
+// printVersionInfos prints versions in the selected machine-readable format
+func printVersionInfos(infos []versionInfo) error {
+	if outputFormat == outputPlain {
+		for _, info := range infos {
+			fmt.Println(info.Version)
+		}
+		return nil
+	}
+	if infos == nil {
+		infos = []versionInfo{}
+	}
+	return printStructured(os.Stdout, infos)
+}
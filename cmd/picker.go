@@ -0,0 +1,309 @@
+/*
+Copyright © 2025 Denys Makeienko <denys.makeienko@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+// Number of versions shown at once by the interactive picker
+const pickerPageSize = 15
+
+var errPickerCancelled = errors.New("selection cancelled")
+
+// flags
+var interactive bool
+
+type pickerItem struct {
+	Version   string
+	Installed bool
+	Allowed   bool
+}
+
+// getPickerItems merges remote and local versions, marking installed versions and versions satisfying required_version
+func getPickerItems() ([]pickerItem, error) {
+	localVersions, _ := getLocalTerraformVersions(PreReleaseVersionsIncluded)
+	remoteVersions, err := getRemoteTerraformVersions(PreReleaseVersionsIncluded)
+	if err != nil {
+		LogWarn("Failed to get remote versions, showing only installed: %v", err)
+	}
+
+	var constraints *semver.Constraints
+	if constraint, err := getTerraformVersionConstraint(); err == nil {
+		constraints, _ = semver.NewConstraint(constraint)
+	}
+
+	installed := make(map[string]bool, len(localVersions))
+	for _, v := range localVersions {
+		installed[v] = true
+	}
+
+	seen := make(map[string]bool)
+	var versions []*semver.Version
+	for _, v := range append(remoteVersions, localVersions...) {
+		if seen[v] {
+			continue
+		}
+		seen[v] = true
+		if version, err := semver.NewVersion(v); err == nil {
+			versions = append(versions, version)
+		}
+	}
+	if len(versions) == 0 {
+		return nil, fmt.Errorf("no terraform versions found")
+	}
+	sort.Sort(sort.Reverse(semver.Collection(versions)))
+
+	items := make([]pickerItem, 0, len(versions))
+	for _, v := range versions {
+		items = append(items, pickerItem{
+			Version:   v.String(),
+			Installed: installed[v.String()],
+			Allowed:   constraints != nil && constraints.Check(v),
+		})
+	}
+	return items, nil
+}
+
+// fuzzyMatch reports whether all characters of query appear in s in order
+func fuzzyMatch(s, query string) bool {
+	for _, c := range query {
+		i := strings.IndexRune(s, c)
+		if i < 0 {
+			return false
+		}
+		s = s[i+1:]
+	}
+	return true
+}
+
+func filterPickerItems(items []pickerItem, query string) []pickerItem {
+	var filtered []pickerItem
+	for _, item := range items {
+		if fuzzyMatch(item.Version, query) {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
+
+func formatPickerItem(item pickerItem) string {
+	line := item.Version
+	if item.Installed {
+		line = Green + line + " (installed)" + Reset
+	}
+	if item.Allowed {
+		line += " " + Cyan + "*" + Reset
+	}
+	return line
+}
+
+// isFullTTY reports whether stdin and stdout are terminals capable of interactive rendering
+func isFullTTY() bool {
+	if os.Getenv("TERM") == "" || os.Getenv("TERM") == "dumb" {
+		return false
+	}
+	for _, f := range []*os.File{os.Stdin, os.Stdout} {
+		stat, err := f.Stat()
+		if err != nil || stat.Mode()&os.ModeCharDevice == 0 {
+			return false
+		}
+	}
+	_, err := exec.LookPath("stty")
+	return err == nil
+}
+
+// pickVersion lets the user pick a version, using a fuzzy finder on a full TTY and a numbered prompt otherwise
+func pickVersion(items []pickerItem) (string, error) {
+	if isFullTTY() {
+		return pickVersionFuzzy(items)
+	}
+	return pickVersionNumbered(items, os.Stdin, os.Stdout)
+}
+
+func pickVersionNumbered(items []pickerItem, in io.Reader, out io.Writer) (string, error) {
+	reader := bufio.NewReader(in)
+	filtered := items
+	for {
+		for i, item := range filtered {
+			fmt.Fprintf(out, "%4d) %s\n", i+1, formatPickerItem(item))
+		}
+		fmt.Fprint(out, "Enter a number, text to filter or empty line to cancel: ")
+
+		line, err := reader.ReadString('\n')
+		line = strings.TrimSpace(line)
+		if line == "" {
+			if err != nil && err != io.EOF {
+				return "", err
+			}
+			return "", errPickerCancelled
+		}
+
+		if n, convErr := strconv.Atoi(line); convErr == nil {
+			if n < 1 || n > len(filtered) {
+				fmt.Fprintf(out, "Invalid number %d\n", n)
+				continue
+			}
+			return filtered[n-1].Version, nil
+		}
+
+		matches := filterPickerItems(items, line)
+		if len(matches) == 0 {
+			fmt.Fprintf(out, "No versions match %q\n", line)
+			continue
+		}
+		filtered = matches
+		if err == io.EOF {
+			return "", errPickerCancelled
+		}
+	}
+}
+
+// stty runs stty against the terminal and returns its output
+func stty(tty *os.File, args ...string) (string, error) {
+	// #nosec G204 -- arguments are constant
+	c := exec.Command("stty", args...)
+	c.Stdin = tty
+	out, err := c.Output()
+	return strings.TrimSpace(string(out)), err
+}
+
+func pickVersionFuzzy(items []pickerItem) (string, error) {
+	tty, err := os.OpenFile("/dev/tty", os.O_RDWR, 0)
+	if err != nil {
+		return pickVersionNumbered(items, os.Stdin, os.Stdout)
+	}
+	defer tty.Close()
+
+	state, err := stty(tty, "-g")
+	if err != nil {
+		return pickVersionNumbered(items, os.Stdin, os.Stdout)
+	}
+	if _, err := stty(tty, "raw", "-echo"); err != nil {
+		return pickVersionNumbered(items, os.Stdin, os.Stdout)
+	}
+	defer func() {
+		_, _ = stty(tty, state)
+	}()
+
+	query := ""
+	cursor, offset := 0, 0
+	renderedLines := 0
+	buf := make([]byte, 16)
+
+	for {
+		filtered := filterPickerItems(items, query)
+		if cursor >= len(filtered) {
+			cursor = len(filtered) - 1
+		}
+		if cursor < 0 {
+			cursor = 0
+		}
+		if cursor < offset {
+			offset = cursor
+		}
+		if cursor >= offset+pickerPageSize {
+			offset = cursor - pickerPageSize + 1
+		}
+
+		// Redraw in place: move to the first rendered line and clear everything below
+		var sb strings.Builder
+		if renderedLines > 0 {
+			fmt.Fprintf(&sb, "\r\033[%dA", renderedLines)
+		}
+		sb.WriteString("\r\033[J")
+		fmt.Fprintf(&sb, "%s> %s%s  (%d/%d, * satisfies required_version, enter to select, esc to cancel)\r\n", Cyan, Reset, query, len(filtered), len(items))
+		renderedLines = 1
+		for i := offset; i < len(filtered) && i < offset+pickerPageSize; i++ {
+			prefix := "  "
+			if i == cursor {
+				prefix = Yellow + "> " + Reset
+			}
+			sb.WriteString(prefix + formatPickerItem(filtered[i]) + "\r\n")
+			renderedLines++
+		}
+		if _, err := tty.WriteString(sb.String()); err != nil {
+			return "", err
+		}
+
+		n, err := tty.Read(buf)
+		if err != nil {
+			return "", err
+		}
+		key := buf[:n]
+
+		switch {
+		case len(key) >= 3 && key[0] == 27 && key[1] == '[' && key[2] == 'A', len(key) == 1 && key[0] == 16: // Up, Ctrl-P
+			cursor--
+		case len(key) >= 3 && key[0] == 27 && key[1] == '[' && key[2] == 'B', len(key) == 1 && key[0] == 14: // Down, Ctrl-N
+			cursor++
+		case len(key) == 1 && (key[0] == 13 || key[0] == 10): // Enter
+			clearRendered(tty, renderedLines)
+			if len(filtered) == 0 {
+				return "", errPickerCancelled
+			}
+			return filtered[cursor].Version, nil
+		case len(key) == 1 && (key[0] == 27 || key[0] == 3 || key[0] == 4): // Esc, Ctrl-C, Ctrl-D
+			clearRendered(tty, renderedLines)
+			return "", errPickerCancelled
+		case len(key) == 1 && (key[0] == 127 || key[0] == 8): // Backspace
+			if query != "" {
+				query = query[:len(query)-1]
+				cursor = 0
+			}
+		case len(key) > 1 && key[0] == 27: // Other escape sequences
+		case len(key) == 1 && key[0] == 21: // Ctrl-U
+			query = ""
+			cursor = 0
+		default:
+			for _, c := range key {
+				if c >= 32 && c < 127 {
+					query += string(rune(c))
+					cursor = 0
+				}
+			}
+		}
+	}
+}
+
+func clearRendered(tty *os.File, renderedLines int) {
+	_, _ = fmt.Fprintf(tty, "\r\033[%dA\r\033[J", renderedLines)
+}
+
+// pickVersionInteractively shows the picker and returns the selected version
+func pickVersionInteractively() (string, error) {
+	items, err := getPickerItems()
+	if err != nil {
+		return "", err
+	}
+	return pickVersion(items)
+}
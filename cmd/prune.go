@@ -0,0 +1,275 @@
+/*
+Copyright © 2025 Denys Makeienko <denys.makeienko@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/spf13/cobra"
+)
+
+// pruneOptions is the retention policy of the prune command, zero values disable a policy
+type pruneOptions struct {
+	KeepLatestPerMinor int
+	Keep               int
+	OlderThan          time.Duration
+	UnusedFor          time.Duration
+	ProtectPins        []string
+}
+
+// prunedVersion is a version selected for removal
+type prunedVersion struct {
+	Version string
+	Details localVersionDetails
+}
+
+var (
+	pruneKeepLatestPerMinor int
+	pruneKeep               int
+	pruneOlderThan          string
+	pruneUnusedFor          string
+	pruneProtectPins        []string
+	pruneDryRun             bool
+)
+
+// parseAge parses a duration with additional d (days) and w (weeks) units, e.g. 90d or 2w
+func parseAge(value string) (time.Duration, error) {
+	units := map[string]time.Duration{"d": 24 * time.Hour, "w": 7 * 24 * time.Hour}
+	for suffix, unit := range units {
+		if number, ok := strings.CutSuffix(value, suffix); ok {
+			n, err := strconv.Atoi(number)
+			if err != nil || n < 0 {
+				return 0, fmt.Errorf("invalid duration %q", value)
+			}
+			return time.Duration(n) * unit, nil
+		}
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q, expected e.g. 90d, 2w or 12h", value)
+	}
+	return duration, nil
+}
+
+// getPinnedVersions returns exact versions pinned by .terraform-version files in the directories and their subdirectories
+func getPinnedVersions(roots []string) (map[string]bool, error) {
+	pinned := make(map[string]bool)
+	for _, root := range roots {
+		dirs, err := findTerraformDirectories(root)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan %s: %w", root, err)
+		}
+		for _, dir := range dirs {
+			version, err := readVersionFromFileIn(dir)
+			if err != nil {
+				continue
+			}
+			if aliasVersion, _, isAlias, err := expandAlias(version); err == nil && isAlias {
+				version = aliasVersion
+			}
+			if v, err := semver.NewVersion(version); err == nil {
+				pinned[v.String()] = true
+			}
+		}
+	}
+	return pinned, nil
+}
+
+// getPruneCandidates returns installed versions that are not protected or kept by the policy.
+// Versions are expected in descending order.
+func getPruneCandidates(versions []string, details map[string]localVersionDetails, opts pruneOptions) ([]prunedVersion, error) {
+	protected := make(map[string]bool)
+	if currentVersion, err := getCurrentTerraformVersion(); err == nil {
+		protected[currentVersion] = true
+	}
+	if len(opts.ProtectPins) > 0 {
+		pinned, err := getPinnedVersions(opts.ProtectPins)
+		if err != nil {
+			return nil, err
+		}
+		for v := range pinned {
+			protected[v] = true
+		}
+	}
+	for _, v := range versions {
+		if len(getAliasesReferencing(v)) > 0 {
+			protected[v] = true
+		}
+	}
+
+	kept := make(map[string]bool)
+	if opts.Keep > 0 {
+		for i := 0; i < opts.Keep && i < len(versions); i++ {
+			kept[versions[i]] = true
+		}
+	}
+	if opts.KeepLatestPerMinor > 0 {
+		perMinor := make(map[string]int)
+		for _, versionStr := range versions {
+			v, err := semver.NewVersion(versionStr)
+			if err != nil {
+				continue
+			}
+			minor := fmt.Sprintf("%d.%d", v.Major(), v.Minor())
+			if perMinor[minor] < opts.KeepLatestPerMinor {
+				kept[versionStr] = true
+			}
+			perMinor[minor]++
+		}
+	}
+
+	now := time.Now()
+	var candidates []prunedVersion
+	for _, v := range versions {
+		if protected[v] || kept[v] {
+			continue
+		}
+		d := details[v]
+		if opts.OlderThan > 0 && (d.InstallDate.IsZero() || now.Sub(d.InstallDate) < opts.OlderThan) {
+			continue
+		}
+		// Versions never used are considered unused for any period
+		if opts.UnusedFor > 0 && !d.LastUsed.IsZero() && now.Sub(d.LastUsed) < opts.UnusedFor {
+			continue
+		}
+		candidates = append(candidates, prunedVersion{Version: v, Details: d})
+	}
+	return candidates, nil
+}
+
+func printPruneCandidates(candidates []prunedVersion) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tSIZE\tINSTALLED\tLAST USED")
+	for _, c := range candidates {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", c.Version, formatBytes(c.Details.Size), formatTime(c.Details.InstallDate), formatTime(c.Details.LastUsed))
+	}
+	_ = w.Flush()
+}
+
+// pruneCmd represents the prune command
+var pruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "Remove installed Terraform versions according to retention policies",
+	Long: `Remove installed Terraform versions according to retention policies.
+
+Versions kept by --keep or --keep-latest-per-minor are never removed. Other versions are removed
+if they match all of --older-than and --unused-for. The active version, versions referenced by
+aliases and versions pinned in directories passed via --protect-pins are always kept.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		// Retention settings from config are defaults of the flags
+		if !flagChanged(cmd, "keep") {
+			pruneKeep = getSettingInt("retention.keep")
+		}
+		if !flagChanged(cmd, "keep-latest-per-minor") {
+			pruneKeepLatestPerMinor = getSettingInt("retention.keep_latest_per_minor")
+		}
+		if !flagChanged(cmd, "older-than") {
+			pruneOlderThan = getSettingString("retention.older_than")
+		}
+		if !flagChanged(cmd, "unused-for") {
+			pruneUnusedFor = getSettingString("retention.unused_for")
+		}
+
+		opts := pruneOptions{
+			KeepLatestPerMinor: pruneKeepLatestPerMinor,
+			Keep:               pruneKeep,
+			ProtectPins:        pruneProtectPins,
+		}
+		if pruneOlderThan != "" {
+			duration, err := parseAge(pruneOlderThan)
+			if err != nil {
+				FatalError("%v", err)
+			}
+			opts.OlderThan = duration
+		}
+		if pruneUnusedFor != "" {
+			duration, err := parseAge(pruneUnusedFor)
+			if err != nil {
+				FatalError("%v", err)
+			}
+			opts.UnusedFor = duration
+		}
+		if opts.KeepLatestPerMinor <= 0 && opts.Keep <= 0 && opts.OlderThan <= 0 && opts.UnusedFor <= 0 {
+			FatalError("No retention policy specified, use --keep, --keep-latest-per-minor, --older-than or --unused-for")
+		}
+
+		versions, err := getLocalTerraformVersions(true)
+		if err != nil {
+			FatalError("failed to list installed versions: %v", err)
+		}
+		usage, err := readUsage()
+		if err != nil {
+			LogWarn("%v", err)
+		}
+		details := make(map[string]localVersionDetails, len(versions))
+		for _, v := range versions {
+			details[v] = getLocalVersionDetails(v, usage)
+		}
+
+		candidates, err := getPruneCandidates(versions, details, opts)
+		if err != nil {
+			FatalError("%v", err)
+		}
+		if len(candidates) == 0 {
+			LogInfo("Nothing to prune")
+			return
+		}
+
+		if pruneDryRun {
+			var freed int64
+			for _, c := range candidates {
+				freed += c.Details.Size
+			}
+			fmt.Println(Green + "Versions that would be removed:" + Reset)
+			printPruneCandidates(candidates)
+			fmt.Printf("Would free %s\n", formatBytes(freed))
+			return
+		}
+
+		pruned := 0
+		var freed int64
+		for _, c := range candidates {
+			if uninstallTerraform(c.Version) == nil {
+				pruned++
+				freed += c.Details.Size
+			}
+		}
+		LogInfo("Pruned %d version(s), freed %s", pruned, formatBytes(freed))
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(pruneCmd)
+	pruneCmd.Flags().IntVarP(&pruneKeepLatestPerMinor, "keep-latest-per-minor", "", 0, "Keep N latest versions of each minor version line")
+	pruneCmd.Flags().IntVarP(&pruneKeep, "keep", "", 0, "Keep N latest versions")
+	pruneCmd.Flags().StringVarP(&pruneOlderThan, "older-than", "", "", "Remove only versions installed longer ago than the duration, e.g. 90d")
+	pruneCmd.Flags().StringVarP(&pruneUnusedFor, "unused-for", "", "", "Remove only versions not used for the duration, e.g. 30d")
+	pruneCmd.Flags().StringArrayVarP(&pruneProtectPins, "protect-pins", "", nil, "Keep versions pinned by .terraform-version files in the directory, can be repeated")
+	pruneCmd.Flags().BoolVarP(&pruneDryRun, "dry-run", "", false, "Show what would be removed without removing anything")
+}
@@ -0,0 +1,203 @@
+/*
+Copyright © 2025 Denys Makeienko <denys.makeienko@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+// terraformBuild is a single OS/arch build of a release
+type terraformBuild struct {
+	Arch string `json:"arch"`
+	OS   string `json:"os"`
+	URL  string `json:"url"`
+}
+
+// terraformRelease is release metadata returned by the releases API
+type terraformRelease struct {
+	Version          string           `json:"version"`
+	IsPrerelease     bool             `json:"is_prerelease"`
+	TimestampCreated time.Time        `json:"timestamp_created"`
+	Builds           []terraformBuild `json:"builds"`
+	URLShasums       string           `json:"url_shasums"`
+	URLChangelog     string           `json:"url_changelog"`
+}
+
+// releasesAPIPageLimit is the maximum page size supported by the releases API
+const releasesAPIPageLimit = 20
+
+func newHTTPClient(timeout time.Duration) *http.Client {
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				MinVersion: tls.VersionTLS12,
+			},
+		},
+	}
+}
+
+// httpRequest sends the request and returns the response with 200 status code. Caller must close the body.
+func httpRequest(method, requestURL string) (*http.Response, error) {
+	client := newHTTPClient(15 * time.Second)
+
+	req, err := http.NewRequest(method, requestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("User-Agent", "tfenvgo/"+Version)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", requestURL, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("failed to fetch %s, status code: %d", requestURL, resp.StatusCode)
+	}
+	return resp, nil
+}
+
+// getJSON fetches the URL and decodes JSON response into v
+func getJSON(requestURL string, v interface{}) error {
+	resp, err := httpRequest("GET", requestURL)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode response from %s: %w", requestURL, err)
+	}
+	return nil
+}
+
+// getText fetches the URL and returns the response body, limited to maxSize bytes
+func getText(requestURL string, maxSize int64) (string, error) {
+	resp, err := httpRequest("GET", requestURL)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxSize))
+	if err != nil {
+		return "", fmt.Errorf("failed to read response from %s: %w", requestURL, err)
+	}
+	return string(body), nil
+}
+
+// readSource reads a URL or a local file path, file:// prefix is optional for local files
+func readSource(source string, maxSize int64) (string, error) {
+	if strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") {
+		return getText(source, maxSize)
+	}
+	path := strings.TrimPrefix(source, "file://")
+	file, err := os.Open(path) // #nosec G304 -- reading user provided file is intended
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	defer file.Close()
+	data, err := io.ReadAll(io.LimitReader(file, maxSize))
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	return string(data), nil
+}
+
+// getContentLength returns the size of the resource without downloading it
+func getContentLength(requestURL string) (int64, error) {
+	resp, err := httpRequest("HEAD", requestURL)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	return resp.ContentLength, nil
+}
+
+// getArchiveName returns the name of the release archive for the platform
+func getArchiveName(version, osType, arch string) string {
+	return "terraform_" + version + "_" + osType + "_" + arch + ".zip"
+}
+
+// getArchiveSHA256 returns the checksum of the archive from the release SHA256SUMS file
+func getArchiveSHA256(version, archiveName string) (string, error) {
+	shasumsURL := getMirrorURL() + "/" + version + "/terraform_" + version + "_SHA256SUMS"
+	shasums, err := getText(shasumsURL, 1024*1024)
+	if err != nil {
+		return "", err
+	}
+	for _, line := range strings.Split(shasums, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 2 && fields[1] == archiveName {
+			return fields[0], nil
+		}
+	}
+	return "", fmt.Errorf("checksum for %s not found", archiveName)
+}
+
+// getTerraformReleases returns metadata of all releases created after since, newest first
+func getTerraformReleases(since time.Time) ([]terraformRelease, error) {
+	var releases []terraformRelease
+	after := ""
+	for {
+		query := url.Values{}
+		query.Set("limit", fmt.Sprint(releasesAPIPageLimit))
+		if after != "" {
+			query.Set("after", after)
+		}
+
+		var page []terraformRelease
+		if err := getJSON(terraformReleasesAPIURL+"?"+query.Encode(), &page); err != nil {
+			return nil, err
+		}
+
+		for _, release := range page {
+			if release.TimestampCreated.Before(since) {
+				return releases, nil
+			}
+			releases = append(releases, release)
+		}
+
+		if len(page) < releasesAPIPageLimit {
+			return releases, nil
+		}
+		after = page[len(page)-1].TimestampCreated.Format(time.RFC3339Nano)
+	}
+}
+
+// getTerraformRelease returns metadata of a single release
+func getTerraformRelease(version string) (*terraformRelease, error) {
+	var release terraformRelease
+	if err := getJSON(terraformReleasesAPIURL+"/"+url.PathEscape(version), &release); err != nil {
+		return nil, err
+	}
+	return &release, nil
+}
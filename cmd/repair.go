@@ -0,0 +1,108 @@
+/*
+Copyright © 2025 Denys Makeienko <denys.makeienko@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	repairAll      bool
+	repairUpstream bool
+)
+
+// repairVersion reinstalls the version in place, the old directory is restored if the install fails
+func repairVersion(version string) error {
+	versionDir := filepath.Join(terraformVersionPath, version)
+	backupDir := filepath.Join(terraformVersionPath, "."+version+".repair")
+	if manifest, err := readManifest(version); err == nil && manifest.origin() == "import" {
+		LogWarn("Terraform v%s was imported from %s, reinstalling it from %s", version, manifest.SourceURL, getMirrorURL())
+	}
+
+	_ = os.RemoveAll(backupDir)
+	if err := os.Rename(versionDir, backupDir); err != nil {
+		return fmt.Errorf("failed to move %s aside: %w", versionDir, err)
+	}
+	if err := installTerraform(version); err != nil {
+		_ = os.RemoveAll(versionDir)
+		if restoreErr := os.Rename(backupDir, versionDir); restoreErr != nil {
+			LogWarn("Failed to restore %s from %s: %v", versionDir, backupDir, restoreErr)
+		}
+		return err
+	}
+	if err := os.RemoveAll(backupDir); err != nil {
+		LogWarn("Failed to remove %s: %v", backupDir, err)
+	}
+
+	if result := verifyVersion(version, false); result.broken() {
+		return fmt.Errorf("reinstalled version is still %s: %s", result.Status, result.Message)
+	}
+	return nil
+}
+
+// repairCmd represents the repair command
+var repairCmd = &cobra.Command{
+	Use:   "repair [version]",
+	Short: "Reinstall corrupted or incomplete Terraform versions",
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := initConfig(); err != nil {
+			FatalError("Failed to create config: %v", err)
+		}
+		versions, err := getVerifyTargets(args, repairAll)
+		if err != nil {
+			FatalError("%v", err)
+		}
+
+		repaired, failed := 0, 0
+		for _, version := range versions {
+			result := verifyVersion(version, repairUpstream)
+			if !result.broken() {
+				LogInfo("Terraform v%s is %s", version, result.Status)
+				continue
+			}
+			LogWarn("Terraform v%s is %s: %s", version, result.Status, result.Message)
+			if err := repairVersion(version); err != nil {
+				LogError("Failed to repair v%s: %v", version, err)
+				failed++
+				continue
+			}
+			LogInfo("Repaired Terraform v%s", version)
+			repaired++
+		}
+		LogInfo("Repaired %d version(s)", repaired)
+
+		if failed > 0 {
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(repairCmd)
+	repairCmd.Flags().BoolVarP(&repairAll, "all", "a", false, "Repair all installed versions")
+	repairCmd.Flags().BoolVarP(&repairUpstream, "upstream", "", false, "Compare with the binary from the mirror even if the install manifest has a checksum")
+}
@@ -35,6 +35,25 @@ var rootCmd = &cobra.Command{
 	Use:     "tfenvgo",
 	Version: Version,
 	Short:   "tfenvgo is a simple Terraform version manager written in Go",
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		// Completion output is parsed by the shell, settings only affect logging there
+		if cmd.Name() == cobra.ShellCompRequestCmd || cmd.Name() == cobra.ShellCompNoDescRequestCmd {
+			return nil
+		}
+		// Paths are initialized before flags are parsed, so they are reinitialized for --root
+		if flagChanged(cmd, "root") {
+			if err := initPaths(); err != nil {
+				return err
+			}
+		}
+		return applySettings(cmd)
+	},
+}
+
+func init() {
+	rootCmd.PersistentFlags().BoolVarP(&refreshRemoteCache, "refresh", "", false, "Bypass the remote version list cache")
+	rootCmd.PersistentFlags().StringVarP(&rootFlag, "root", "", "", "Root directory for versions and data, overrides TFENVGO_ROOT")
+	rootCmd.PersistentFlags().StringVarP(&logLevelFlag, "log-level", "", "", "Log level: error, warn, info or debug")
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
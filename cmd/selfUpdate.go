@@ -0,0 +1,323 @@
+/*
+Copyright © 2025 Denys Makeienko <denys.makeienko@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/spf13/cobra"
+)
+
+var (
+	selfUpdateVersion string
+	selfUpdateCheck   bool
+)
+
+// selfRelease is a release in the GitHub releases API response
+type selfRelease struct {
+	TagName    string             `json:"tag_name"`
+	Draft      bool               `json:"draft"`
+	Prerelease bool               `json:"prerelease"`
+	Assets     []selfReleaseAsset `json:"assets"`
+}
+
+type selfReleaseAsset struct {
+	Name string `json:"name"`
+	URL  string `json:"browser_download_url"`
+}
+
+// selfArchNames are the names an architecture can have in asset names, Go names and uname -m output
+var selfArchNames = map[string][]string{
+	"amd64": {"amd64", "x86_64"},
+	"arm64": {"arm64", "aarch64"},
+	"386":   {"386", "i386"},
+}
+
+func getSelfReleases() ([]selfRelease, error) {
+	var releases []selfRelease
+	if err := getJSON(getSettingString("self_update_url"), &releases); err != nil {
+		return nil, err
+	}
+	return releases, nil
+}
+
+// selectSelfRelease returns the release with the given version or the latest one
+func selectSelfRelease(releases []selfRelease, version string) (selfRelease, *semver.Version, error) {
+	var wanted *semver.Version
+	if version != "" {
+		v, err := semver.NewVersion(version)
+		if err != nil {
+			return selfRelease{}, nil, fmt.Errorf("invalid version %q: %w", version, err)
+		}
+		wanted = v
+	}
+
+	var (
+		selected        selfRelease
+		selectedVersion *semver.Version
+	)
+	includePrerelease := getSettingBool("include_prerelease")
+	for _, release := range releases {
+		v, err := semver.NewVersion(release.TagName)
+		if err != nil || release.Draft {
+			continue
+		}
+		if wanted != nil {
+			if v.Equal(wanted) {
+				return release, v, nil
+			}
+			continue
+		}
+		if release.Prerelease && !includePrerelease {
+			continue
+		}
+		if selectedVersion == nil || v.GreaterThan(selectedVersion) {
+			selected, selectedVersion = release, v
+		}
+	}
+	if wanted != nil {
+		return selfRelease{}, nil, fmt.Errorf("release %s not found", version)
+	}
+	if selectedVersion == nil {
+		return selfRelease{}, nil, fmt.Errorf("no releases found")
+	}
+	return selected, selectedVersion, nil
+}
+
+// findSelfAsset returns the tfenvgo-<version>-<OS>-<arch>.tar.gz asset for the host platform
+func findSelfAsset(release selfRelease) (selfReleaseAsset, error) {
+	archNames, ok := selfArchNames[runtime.GOARCH]
+	if !ok {
+		archNames = []string{runtime.GOARCH}
+	}
+	for _, asset := range release.Assets {
+		for _, arch := range archNames {
+			if strings.EqualFold(asset.Name, fmt.Sprintf("tfenvgo-%s-%s-%s.tar.gz", release.TagName, runtime.GOOS, arch)) {
+				return asset, nil
+			}
+		}
+	}
+	return selfReleaseAsset{}, fmt.Errorf("release %s has no asset for %s/%s", release.TagName, runtime.GOOS, runtime.GOARCH)
+}
+
+// getSelfAssetSHA256 returns the checksum of the asset from its .sha256 file or the release checksums.txt
+func getSelfAssetSHA256(release selfRelease, asset selfReleaseAsset) (string, error) {
+	for _, candidate := range release.Assets {
+		if candidate.Name != asset.Name+".sha256" && !strings.EqualFold(candidate.Name, "checksums.txt") {
+			continue
+		}
+		checksums, err := getText(candidate.URL, 1024*1024)
+		if err != nil {
+			return "", err
+		}
+		for _, line := range strings.Split(checksums, "\n") {
+			fields := strings.Fields(line)
+			switch {
+			case len(fields) == 1 && candidate.Name == asset.Name+".sha256":
+				return fields[0], nil
+			case len(fields) == 2 && strings.TrimPrefix(fields[1], "*") == asset.Name:
+				return fields[0], nil
+			}
+		}
+	}
+	return "", fmt.Errorf("checksum for %s not found", asset.Name)
+}
+
+// extractSelfBinary extracts the tfenvgo binary from the archive into a temp file in dir
+func extractSelfBinary(archivePath, dir string) (string, error) {
+	file, err := os.Open(archivePath) // #nosec G304 -- path is controlled by tfenvgo
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		return "", fmt.Errorf("failed to open archive: %w", err)
+	}
+	defer gz.Close()
+
+	const maxBinarySize = 200 * 1024 * 1024 // 200MB limit
+	archive := tar.NewReader(gz)
+	for {
+		header, err := archive.Next()
+		if err == io.EOF {
+			return "", fmt.Errorf("archive contains no tfenvgo binary")
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to read archive: %w", err)
+		}
+		if name := path.Base(header.Name); header.Typeflag != tar.TypeReg || (name != "tfenvgo" && name != "tfenvgo.exe") {
+			continue
+		}
+
+		tmpFile, err := os.CreateTemp(dir, ".tfenvgo-update-*")
+		if err != nil {
+			return "", err
+		}
+		if _, err := io.Copy(tmpFile, io.LimitReader(archive, maxBinarySize)); err != nil {
+			_ = tmpFile.Close()
+			_ = os.Remove(tmpFile.Name())
+			return "", fmt.Errorf("failed to extract binary: %w", err)
+		}
+		if err := tmpFile.Close(); err != nil {
+			_ = os.Remove(tmpFile.Name())
+			return "", err
+		}
+		return tmpFile.Name(), nil
+	}
+}
+
+// replaceExecutable atomically replaces exe with the new binary, keeping its permissions
+func replaceExecutable(newBinary, exe string) error {
+	mode := os.FileMode(0o755)
+	if stat, err := os.Stat(exe); err == nil {
+		mode = stat.Mode().Perm()
+	}
+	if err := os.Chmod(newBinary, mode); err != nil {
+		return err
+	}
+	if runtime.GOOS != "windows" {
+		return os.Rename(newBinary, exe)
+	}
+
+	// Running executables can't be overwritten on Windows, but can be renamed
+	oldExe := exe + ".old"
+	_ = os.Remove(oldExe)
+	if err := os.Rename(exe, oldExe); err != nil {
+		return err
+	}
+	if err := os.Rename(newBinary, exe); err != nil {
+		if restoreErr := os.Rename(oldExe, exe); restoreErr != nil {
+			return fmt.Errorf("%w, restoring %s from %s failed: %v", err, exe, oldExe, restoreErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// getOwnVersion returns the version of the running binary, builds from the Makefile have the commit hash appended
+func getOwnVersion() *semver.Version {
+	v, err := semver.NewVersion(Version)
+	if err != nil {
+		return nil
+	}
+	core, _ := v.SetPrerelease("")
+	return &core
+}
+
+func selfUpdate(release selfRelease) error {
+	asset, err := findSelfAsset(release)
+	if err != nil {
+		return err
+	}
+	// The checksum is always verified, the verification setting applies to Terraform archives only
+	expected, err := getSelfAssetSHA256(release, asset)
+	if err != nil {
+		return fmt.Errorf("failed to get checksum: %w", err)
+	}
+
+	exe, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("failed to locate the running executable: %w", err)
+	}
+	if resolved, err := filepath.EvalSymlinks(exe); err == nil {
+		exe = resolved
+	}
+
+	LogInfo("Downloading %s", asset.URL)
+	archivePath, err := downloadFile(asset.URL, "tfenvgo-*.tar.gz")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(archivePath)
+
+	actual, err := fileSHA256(archivePath)
+	if err != nil {
+		return fmt.Errorf("failed to compute checksum: %w", err)
+	}
+	if !strings.EqualFold(actual, expected) {
+		return fmt.Errorf("checksum mismatch for %s: expected %s, got %s", asset.Name, expected, actual)
+	}
+	LogInfo("Verified checksum of %s", asset.Name)
+
+	newBinary, err := extractSelfBinary(archivePath, filepath.Dir(exe))
+	if err != nil {
+		return fmt.Errorf("failed to prepare update in %s, check write permissions: %w", filepath.Dir(exe), err)
+	}
+	if err := replaceExecutable(newBinary, exe); err != nil {
+		_ = os.Remove(newBinary)
+		return fmt.Errorf("failed to replace %s: %w", exe, err)
+	}
+	return nil
+}
+
+// selfUpdateCmd represents the self-update command
+var selfUpdateCmd = &cobra.Command{
+	Use:   "self-update",
+	Short: "Update tfenvgo to the latest or a specific release",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		releases, err := getSelfReleases()
+		if err != nil {
+			FatalError("Failed to get tfenvgo releases: %v", err)
+		}
+		release, releaseVersion, err := selectSelfRelease(releases, selfUpdateVersion)
+		if err != nil {
+			FatalError("%v", err)
+		}
+
+		current := getOwnVersion()
+		upToDate := current != nil && !releaseVersion.GreaterThan(current)
+		if selfUpdateCheck {
+			if upToDate {
+				LogInfo("tfenvgo %s is up to date, latest release is %s", Version, release.TagName)
+			} else {
+				LogInfo("tfenvgo %s is available, current version is %s. Run tfenvgo self-update to update", release.TagName, Version)
+			}
+			return
+		}
+		if selfUpdateVersion == "" && upToDate {
+			LogInfo("tfenvgo %s is up to date", Version)
+			return
+		}
+
+		if err := selfUpdate(release); err != nil {
+			FatalError("Failed to update tfenvgo: %v", err)
+		}
+		LogInfo("Updated tfenvgo from %s to %s", Version, release.TagName)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(selfUpdateCmd)
+	selfUpdateCmd.Flags().StringVarP(&selfUpdateVersion, "version", "", "", "Install the given release instead of the latest, e.g. v1.2.3")
+	selfUpdateCmd.Flags().BoolVarP(&selfUpdateCheck, "check", "", false, "Only check whether a newer release is available")
+}
@@ -0,0 +1,237 @@
+/*
+Copyright © 2025 Denys Makeienko <denys.makeienko@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+// newFakeReleaseServer serves a GitHub-compatible releases API with a v2.0.0 release for the host platform
+func newFakeReleaseServer(t *testing.T, archive []byte) *httptest.Server {
+	t.Helper()
+	sum := sha256.Sum256(archive)
+	assetName := fmt.Sprintf("tfenvgo-v2.0.0-%s-%s.tar.gz", runtime.GOOS, runtime.GOARCH)
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/releases", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `[
+			{"tag_name": "v3.0.0-rc1", "prerelease": true, "assets": []},
+			{"tag_name": "v2.1.0", "draft": true, "assets": []},
+			{"tag_name": "v2.0.0", "assets": [
+				{"name": %[1]q, "browser_download_url": "%[2]s/download/%[1]s"},
+				{"name": "%[1]s.sha256", "browser_download_url": "%[2]s/download/%[1]s.sha256"}
+			]},
+			{"tag_name": "v1.0.0", "assets": [
+				{"name": "checksums.txt", "browser_download_url": "%[2]s/download/checksums.txt"}
+			]},
+			{"tag_name": "not-a-version", "assets": []}
+		]`, assetName, server.URL)
+	})
+	mux.HandleFunc("/download/"+assetName, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(archive)
+	})
+	mux.HandleFunc("/download/"+assetName+".sha256", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "%s  %s\n", hex.EncodeToString(sum[:]), assetName)
+	})
+	mux.HandleFunc("/download/checksums.txt", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "%s  other.tar.gz\n%s  tfenvgo-v1.0.0-linux-amd64.tar.gz\n", "00", "11")
+	})
+	return server
+}
+
+func newSelfArchive(t *testing.T, content string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	files := map[string]string{"README.md": "readme", "tfenvgo": content}
+	for _, name := range []string{"README.md", "tfenvgo"} {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0o755, Size: int64(len(files[name])), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(files[name])); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestSelfUpdateReleases(t *testing.T) {
+	archive := newSelfArchive(t, "new binary")
+	server := newFakeReleaseServer(t, archive)
+	t.Setenv(selfUpdateURLEnvKey, server.URL+"/releases")
+	t.Setenv(includePrereleaseEnvKey, "false")
+
+	releases, err := getSelfReleases()
+	if err != nil {
+		t.Fatalf("getSelfReleases: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		version string
+		want    string
+		wantErr bool
+	}{
+		{name: "latest skips drafts and prereleases", want: "v2.0.0"},
+		{name: "exact version", version: "v1.0.0", want: "v1.0.0"},
+		{name: "version without v prefix", version: "1.0.0", want: "v1.0.0"},
+		{name: "explicit prerelease", version: "v3.0.0-rc1", want: "v3.0.0-rc1"},
+		{name: "missing version", version: "v9.9.9", wantErr: true},
+		{name: "invalid version", version: "latest", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			release, _, err := selectSelfRelease(releases, tt.version)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got release %s", release.TagName)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("selectSelfRelease: %v", err)
+			}
+			if release.TagName != tt.want {
+				t.Errorf("got %s, want %s", release.TagName, tt.want)
+			}
+		})
+	}
+
+	t.Run("prerelease included by setting", func(t *testing.T) {
+		t.Setenv(includePrereleaseEnvKey, "true")
+		release, _, err := selectSelfRelease(releases, "")
+		if err != nil || release.TagName != "v3.0.0-rc1" {
+			t.Errorf("got %s, %v, want v3.0.0-rc1", release.TagName, err)
+		}
+	})
+
+	t.Run("asset and checksum", func(t *testing.T) {
+		release, _, _ := selectSelfRelease(releases, "v2.0.0")
+		asset, err := findSelfAsset(release)
+		if err != nil {
+			t.Fatalf("findSelfAsset: %v", err)
+		}
+		sha, err := getSelfAssetSHA256(release, asset)
+		if err != nil {
+			t.Fatalf("getSelfAssetSHA256: %v", err)
+		}
+		sum := sha256.Sum256(archive)
+		if sha != hex.EncodeToString(sum[:]) {
+			t.Errorf("got checksum %s, want %x", sha, sum)
+		}
+
+		archivePath, err := downloadFile(asset.URL, "tfenvgo-test-*.tar.gz")
+		if err != nil {
+			t.Fatalf("downloadFile: %v", err)
+		}
+		defer os.Remove(archivePath)
+		binary, err := extractSelfBinary(archivePath, t.TempDir())
+		if err != nil {
+			t.Fatalf("extractSelfBinary: %v", err)
+		}
+		if data, _ := os.ReadFile(binary); string(data) != "new binary" {
+			t.Errorf("extracted %q, want %q", data, "new binary")
+		}
+	})
+
+	t.Run("missing asset", func(t *testing.T) {
+		release, _, _ := selectSelfRelease(releases, "v1.0.0")
+		if _, err := findSelfAsset(release); err == nil {
+			t.Error("expected error for release without platform asset")
+		}
+		asset := selfReleaseAsset{Name: "tfenvgo-v1.0.0-darwin-arm64.tar.gz"}
+		if _, err := getSelfAssetSHA256(release, asset); err == nil {
+			t.Error("expected error for asset missing in checksums.txt")
+		}
+	})
+}
+
+func TestFindSelfAssetArchNames(t *testing.T) {
+	archNames, ok := selfArchNames[runtime.GOARCH]
+	if !ok {
+		t.Skipf("no alternative names for %s", runtime.GOARCH)
+	}
+	name := fmt.Sprintf("tfenvgo-v1.2.3-%s-%s.tar.gz", runtime.GOOS, archNames[len(archNames)-1])
+	release := selfRelease{TagName: "v1.2.3", Assets: []selfReleaseAsset{{Name: "tfenvgo-v1.2.3-plan9-mips.tar.gz"}, {Name: name}}}
+	asset, err := findSelfAsset(release)
+	if err != nil || asset.Name != name {
+		t.Errorf("got %q, %v, want %q", asset.Name, err, name)
+	}
+}
+
+func TestReplaceExecutable(t *testing.T) {
+	dir := t.TempDir()
+	exe := filepath.Join(dir, "tfenvgo")
+	newBinary := filepath.Join(dir, ".tfenvgo-update")
+	if err := os.WriteFile(exe, []byte("old"), 0o750); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(newBinary, []byte("new"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := replaceExecutable(newBinary, exe); err != nil {
+		t.Fatalf("replaceExecutable: %v", err)
+	}
+	data, err := os.ReadFile(exe)
+	if err != nil || string(data) != "new" {
+		t.Fatalf("got %q, %v, want new", data, err)
+	}
+	if runtime.GOOS != "windows" {
+		stat, _ := os.Stat(exe)
+		if stat.Mode().Perm() != 0o750 {
+			t.Errorf("got mode %v, want 0750", stat.Mode().Perm())
+		}
+	}
+	if _, err := os.Stat(newBinary); !os.IsNotExist(err) {
+		t.Errorf("temp binary %s still exists", newBinary)
+	}
+
+	// A failed replace leaves the executable in place
+	if err := replaceExecutable(filepath.Join(dir, "missing"), exe); err == nil {
+		t.Error("expected error for missing new binary")
+	}
+	if data, _ := os.ReadFile(exe); string(data) != "new" {
+		t.Errorf("executable changed to %q after failed replace", data)
+	}
+}
@@ -0,0 +1,558 @@
+/*
+Copyright © 2025 Denys Makeienko <denys.makeienko@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// Setting sources, in order of precedence after flags and environment variables
+const (
+	sourceRepoConfig = "repo config"
+	sourceUserConfig = "user config"
+	sourceDefault    = "default"
+)
+
+// Verification modes
+const (
+	verificationChecksum = "checksum"
+	verificationNone     = "none"
+)
+
+// Link modes
+const (
+	linkModeCopy     = "copy"
+	linkModeHardlink = "hardlink"
+)
+
+// setting is a configuration key that can be set in config files or via environment variable
+type setting struct {
+	Key         string
+	EnvKey      string
+	Default     string
+	Description string
+	validate    func(string) error
+	// userOnly settings are ignored in repo configs, so a cloned repository can't change where
+	// and how binaries are downloaded
+	userOnly bool
+}
+
+func validateOneOf(values ...string) func(string) error {
+	return func(value string) error {
+		if !containsString(values, value) {
+			return fmt.Errorf("allowed values are: %s", strings.Join(values, ", "))
+		}
+		return nil
+	}
+}
+
+func validateBool(value string) error {
+	_, err := strconv.ParseBool(value)
+	if err != nil {
+		return fmt.Errorf("expected true or false")
+	}
+	return nil
+}
+
+func validateNonNegativeInt(value string) error {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		return fmt.Errorf("expected a non-negative integer")
+	}
+	return nil
+}
+
+func validateAge(value string) error {
+	if value == "" {
+		return nil
+	}
+	_, err := parseAge(value)
+	return err
+}
+
+func validateURL(value string) error {
+	u, err := url.Parse(value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("expected an http or https URL")
+	}
+	return nil
+}
+
+var settings = []setting{
+	{Key: "mirror", EnvKey: mirrorEnvKey, Default: defaultMirrorURL, Description: "Base URL of Terraform releases", validate: validateURL, userOnly: true},
+	{Key: "include_prerelease", EnvKey: includePrereleaseEnvKey, Default: "false", Description: "Include prerelease versions", validate: validateBool},
+	{Key: "auto_install", EnvKey: autoInstallEnvKey, Default: "true", Description: "Install missing versions on use", validate: validateBool, userOnly: true},
+	{Key: "log_level", EnvKey: logLevelEnvKey, Default: "info", Description: "Log level: error, warn, info or debug", validate: validateOneOf("error", "warn", "info", "debug")},
+	{Key: "retention.keep", EnvKey: retentionKeepEnvKey, Default: "0", Description: "Default of prune --keep", validate: validateNonNegativeInt},
+	{Key: "retention.keep_latest_per_minor", EnvKey: retentionKeepLatestPerMinorEnvKey, Default: "0", Description: "Default of prune --keep-latest-per-minor", validate: validateNonNegativeInt},
+	{Key: "retention.older_than", EnvKey: retentionOlderThanEnvKey, Default: "", Description: "Default of prune --older-than", validate: validateAge},
+	{Key: "retention.unused_for", EnvKey: retentionUnusedForEnvKey, Default: "", Description: "Default of prune --unused-for", validate: validateAge},
+	{Key: "verification", EnvKey: verificationEnvKey, Default: verificationChecksum, Description: "Archive verification on install: checksum or none", validate: validateOneOf(verificationChecksum, verificationNone), userOnly: true},
+	{Key: "link_mode", EnvKey: linkModeEnvKey, Default: linkModeCopy, Description: "How import adds binaries: copy or hardlink", validate: validateOneOf(linkModeCopy, linkModeHardlink)},
+	{Key: "self_update_url", EnvKey: selfUpdateURLEnvKey, Default: defaultSelfUpdateURL, Description: "GitHub-compatible releases API of tfenvgo", validate: validateURL, userOnly: true},
+}
+
+func findSetting(key string) (setting, error) {
+	for _, s := range settings {
+		if s.Key == key {
+			return s, nil
+		}
+	}
+	return setting{}, fmt.Errorf("unknown config key %q", key)
+}
+
+// parseConfigYAML parses a config file of nested mappings of scalars.
+// Nested keys are flattened with dots, e.g. retention.keep.
+func parseConfigYAML(data []byte) (map[string]string, error) {
+	var document map[string]interface{}
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+	values := make(map[string]string)
+	if err := flattenConfigYAML("", document, values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+func flattenConfigYAML(prefix string, document map[string]interface{}, values map[string]string) error {
+	for key, value := range document {
+		fullKey := key
+		if prefix != "" {
+			fullKey = prefix + "." + key
+		}
+		switch v := value.(type) {
+		case nil:
+		case map[string]interface{}:
+			if err := flattenConfigYAML(fullKey, v, values); err != nil {
+				return err
+			}
+		case []interface{}:
+			return fmt.Errorf("%s: lists are not supported", fullKey)
+		default:
+			values[fullKey] = fmt.Sprint(v)
+		}
+	}
+	return nil
+}
+
+// configYAMLValue returns booleans and integers as such, so they are written without quotes
+func configYAMLValue(value string) interface{} {
+	if b, err := strconv.ParseBool(value); err == nil && strings.ToLower(value) == strconv.FormatBool(b) {
+		return b
+	}
+	if n, err := strconv.Atoi(value); err == nil && strconv.Itoa(n) == value {
+		return n
+	}
+	return value
+}
+
+// formatConfigYAML writes values as YAML, nesting dotted keys under their prefix
+func formatConfigYAML(values map[string]string) ([]byte, error) {
+	document := make(map[string]interface{})
+	for key, rawValue := range values {
+		value := configYAMLValue(rawValue)
+		parentKey, childKey, nested := strings.Cut(key, ".")
+		if !nested {
+			document[key] = value
+			continue
+		}
+		parent, ok := document[parentKey].(map[string]interface{})
+		if !ok {
+			parent = make(map[string]interface{})
+			document[parentKey] = parent
+		}
+		parent[childKey] = value
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(document); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func readConfigFile(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path) // #nosec G304 -- reading config file is intended
+	if err != nil {
+		return nil, err
+	}
+	values, err := parseConfigYAML(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return values, nil
+}
+
+func writeConfigFile(path string, values map[string]string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return fmt.Errorf("failed to create %s: %w", filepath.Dir(path), err)
+	}
+	data, err := formatConfigYAML(values)
+	if err != nil {
+		return fmt.Errorf("failed to format %s: %w", path, err)
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
+// findRepoConfigFile returns the closest .tfenvgo.yaml in the current directory or its parents
+func findRepoConfigFile() string {
+	dir, err := os.Getwd()
+	if err != nil {
+		return ""
+	}
+	for {
+		path := filepath.Join(dir, repoConfigFilename)
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+		parentDir := filepath.Dir(dir)
+		if parentDir == dir {
+			return ""
+		}
+		dir = parentDir
+	}
+}
+
+// configLayer is a config file with its values
+type configLayer struct {
+	source string
+	path   string
+	values map[string]string
+}
+
+var loadedConfigLayers []configLayer
+
+// warnConfig logs a config warning to stderr. Config is loaded before every command, before
+// commands whose stdout is consumed by scripts or shells can redirect the log output.
+func warnConfig(message string, args ...interface{}) {
+	logMessageTo(os.Stderr, LevelWarn, Yellow, "WARN", fmt.Sprintf(message, args...))
+}
+
+// getConfigLayers loads the repo and user config files, in order of precedence
+func getConfigLayers() []configLayer {
+	if loadedConfigLayers != nil {
+		return loadedConfigLayers
+	}
+	loadedConfigLayers = []configLayer{}
+	candidates := []configLayer{
+		{source: sourceRepoConfig, path: findRepoConfigFile()},
+		{source: sourceUserConfig, path: userConfigFilePath},
+	}
+	for _, layer := range candidates {
+		if layer.path == "" {
+			continue
+		}
+		values, err := readConfigFile(layer.path)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				warnConfig("%v", err)
+			}
+			continue
+		}
+		for key := range values {
+			s, err := findSetting(key)
+			if err != nil {
+				warnConfig("%s: %v", layer.path, err)
+				continue
+			}
+			if s.userOnly && layer.source == sourceRepoConfig {
+				warnConfig("Ignoring %s in %s, it can only be set in the user config", key, layer.path)
+				delete(values, key)
+			}
+		}
+		layer.values = values
+		loadedConfigLayers = append(loadedConfigLayers, layer)
+	}
+	return loadedConfigLayers
+}
+
+// getSetting returns the effective value of the key and where it comes from.
+// Precedence is env > repo config > user config > default, flags are handled by callers.
+func getSetting(key string) (string, string) {
+	s, err := findSetting(key)
+	if err != nil {
+		LogDebug("%v", err)
+		return "", sourceDefault
+	}
+
+	if value := getEnv(s.EnvKey, ""); value != "" {
+		err := s.validate(value)
+		if err == nil {
+			return value, sourceEnv
+		}
+		warnConfig("Ignoring invalid %s value %q: %v", s.EnvKey, value, err)
+	}
+	for _, layer := range getConfigLayers() {
+		value, ok := layer.values[key]
+		if !ok {
+			continue
+		}
+		if err := s.validate(value); err != nil {
+			warnConfig("Ignoring invalid %s value %q in %s: %v", key, value, layer.path, err)
+			continue
+		}
+		return value, layer.source
+	}
+	return s.Default, sourceDefault
+}
+
+func getSettingString(key string) string {
+	value, _ := getSetting(key)
+	return value
+}
+
+func getSettingBool(key string) bool {
+	value, _ := strconv.ParseBool(getSettingString(key))
+	return value
+}
+
+func getSettingInt(key string) int {
+	value, _ := strconv.Atoi(getSettingString(key))
+	return value
+}
+
+// getMirrorURL returns the base URL of Terraform releases without trailing slash
+func getMirrorURL() string {
+	return strings.TrimSuffix(getSettingString("mirror"), "/")
+}
+
+// flagChanged reports whether the flag was explicitly set on the command line
+func flagChanged(cmd *cobra.Command, name string) bool {
+	flag := cmd.Flags().Lookup(name)
+	return flag != nil && flag.Changed
+}
+
+var logLevels = map[string]LogLevel{
+	"error": LevelError,
+	"warn":  LevelWarn,
+	"info":  LevelInfo,
+	"debug": LevelDebug,
+}
+
+// logLevelFlag is the value of the global --log-level flag
+var logLevelFlag string
+
+// applySettings applies settings shared by all commands unless they are overridden by flags
+func applySettings(cmd *cobra.Command) error {
+	logLevel := getSettingString("log_level")
+	if flagChanged(cmd, "log-level") {
+		if _, ok := logLevels[logLevelFlag]; !ok {
+			return fmt.Errorf("invalid --log-level value %q, allowed values are: error, warn, info, debug", logLevelFlag)
+		}
+		logLevel = logLevelFlag
+	}
+	SetLogLevel(logLevels[logLevel])
+
+	if !flagChanged(cmd, "include-prerelease") {
+		PreReleaseVersionsIncluded = getSettingBool("include_prerelease")
+	}
+	return nil
+}
+
+var configRepo bool
+
+// configEntry is a setting with its effective value in config list output
+type configEntry struct {
+	Key    string `json:"key" yaml:"key"`
+	Value  string `json:"value" yaml:"value"`
+	Source string `json:"source" yaml:"source"`
+}
+
+// getConfigTargetFile returns the file config set and unset modify
+func getConfigTargetFile() (string, error) {
+	if !configRepo {
+		return userConfigFilePath, nil
+	}
+	if path := findRepoConfigFile(); path != "" {
+		return path, nil
+	}
+	cwd, err := os.Getwd()
+	if err != nil {
+		return "", fmt.Errorf("error getting current directory: %w", err)
+	}
+	return filepath.Join(cwd, repoConfigFilename), nil
+}
+
+func completeConfigKeys(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	var keys []string
+	for _, s := range settings {
+		if strings.HasPrefix(s.Key, toComplete) {
+			keys = append(keys, s.Key+"\t"+s.Description)
+		}
+	}
+	return keys, cobra.ShellCompDirectiveNoFileComp
+}
+
+// configCmd represents the config command
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Manage tfenvgo configuration",
+	Long: `Manage tfenvgo configuration stored in the user config.yaml and per-repository .tfenvgo.yaml files.
+
+Precedence is flag > environment variable > repo config > user config > default.
+The repo config is the closest .tfenvgo.yaml in the current directory or its parents.`,
+}
+
+var configGetCmd = &cobra.Command{
+	Use:               "get <key>",
+	Short:             "Print the effective value of a config key",
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeConfigKeys,
+	Run: func(cmd *cobra.Command, args []string) {
+		if _, err := findSetting(args[0]); err != nil {
+			FatalError("%v", err)
+		}
+		fmt.Println(getSettingString(args[0]))
+	},
+}
+
+var configSetCmd = &cobra.Command{
+	Use:               "set <key> <value>",
+	Short:             "Set a config key in the user config or, with --repo, in the repo config",
+	Args:              cobra.ExactArgs(2),
+	ValidArgsFunction: completeConfigKeys,
+	Run: func(cmd *cobra.Command, args []string) {
+		key, value := args[0], args[1]
+		s, err := findSetting(key)
+		if err != nil {
+			FatalError("%v", err)
+		}
+		if err := s.validate(value); err != nil {
+			FatalError("Invalid value %q for %s: %v", value, key, err)
+		}
+		if s.userOnly && configRepo {
+			FatalError("%s can only be set in the user config", key)
+		}
+
+		path, err := getConfigTargetFile()
+		if err != nil {
+			FatalError("%v", err)
+		}
+		values, err := readConfigFile(path)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				FatalError("%v", err)
+			}
+			values = make(map[string]string)
+		}
+		values[key] = value
+		if err := writeConfigFile(path, values); err != nil {
+			FatalError("%v", err)
+		}
+		LogInfo("Set %s to %s in %s", key, value, path)
+	},
+}
+
+var configUnsetCmd = &cobra.Command{
+	Use:               "unset <key>",
+	Short:             "Remove a config key from the user config or, with --repo, from the repo config",
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeConfigKeys,
+	Run: func(cmd *cobra.Command, args []string) {
+		path, err := getConfigTargetFile()
+		if err != nil {
+			FatalError("%v", err)
+		}
+		values, err := readConfigFile(path)
+		if err != nil {
+			FatalError("%v", err)
+		}
+		if _, ok := values[args[0]]; !ok {
+			FatalError("%s is not set in %s", args[0], path)
+		}
+		delete(values, args[0])
+		if err := writeConfigFile(path, values); err != nil {
+			FatalError("%v", err)
+		}
+		LogInfo("Removed %s from %s", args[0], path)
+	},
+}
+
+var configListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List all config keys with effective values and their sources",
+	Args:    cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := setupOutput(); err != nil {
+			FatalError("%v", err)
+		}
+
+		entries := make([]configEntry, 0, len(settings))
+		for _, s := range settings {
+			value, source := getSetting(s.Key)
+			entries = append(entries, configEntry{Key: s.Key, Value: value, Source: source})
+		}
+
+		switch outputFormat {
+		case outputJSON, outputYAML:
+			if err := printStructured(os.Stdout, entries); err != nil {
+				FatalError("%v", err)
+			}
+		case outputPlain:
+			for _, entry := range entries {
+				fmt.Printf("%s=%s\n", entry.Key, entry.Value)
+			}
+		default:
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "KEY\tVALUE\tSOURCE")
+			for _, entry := range entries {
+				value := entry.Value
+				if value == "" {
+					value = "-"
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\n", entry.Key, value, entry.Source)
+			}
+			_ = w.Flush()
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(configCmd)
+	configCmd.AddCommand(configGetCmd, configSetCmd, configUnsetCmd, configListCmd)
+	configSetCmd.Flags().BoolVarP(&configRepo, "repo", "", false, "Write to the repo config .tfenvgo.yaml instead of the user config")
+	configUnsetCmd.Flags().BoolVarP(&configRepo, "repo", "", false, "Remove from the repo config .tfenvgo.yaml instead of the user config")
+	configListCmd.Flags().StringVarP(&outputFormat, "output", "o", outputText, "Output format: text, plain, json or yaml")
+}
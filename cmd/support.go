@@ -0,0 +1,210 @@
+/*
+Copyright © 2025 Denys Makeienko <denys.makeienko@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+// Support statuses
+const (
+	supportMaintained = "maintained"
+	supportEOL        = "eol"
+	supportUnknown    = "unknown"
+)
+
+const maxSupportDataSize = 1024 * 1024
+
+//go:embed data/support.json
+var bundledSupportData []byte
+
+// minorLineSupport is the support status of a minor version line, e.g. 1.5
+type minorLineSupport struct {
+	Minor  string `json:"minor"`
+	Status string `json:"status"`
+	EOL    string `json:"eol,omitempty"`
+}
+
+// knownBadVersion is a version, or a constraint matching a range of versions, flagged with an advisory
+type knownBadVersion struct {
+	Version  string `json:"version" yaml:"version"`
+	Reason   string `json:"reason" yaml:"reason"`
+	Advisory string `json:"advisory,omitempty" yaml:"advisory,omitempty"`
+}
+
+type supportData struct {
+	MinorLines []minorLineSupport `json:"minor_lines"`
+	KnownBad   []knownBadVersion  `json:"known_bad"`
+}
+
+// supportStatus is the support status of a single version
+type supportStatus struct {
+	Status   string            `json:"status" yaml:"status"`
+	EOL      string            `json:"eol,omitempty" yaml:"eol,omitempty"`
+	KnownBad []knownBadVersion `json:"known_bad,omitempty" yaml:"known_bad,omitempty"`
+}
+
+// isFlagged reports whether the version is EOL or has advisories
+func (s supportStatus) isFlagged() bool {
+	return s.Status == supportEOL || len(s.KnownBad) > 0
+}
+
+// label returns a short human-readable annotation, empty for maintained or unknown versions
+func (s supportStatus) label() string {
+	switch {
+	case len(s.KnownBad) > 0 && s.Status == supportEOL:
+		return "EOL, flagged"
+	case len(s.KnownBad) > 0:
+		return "flagged"
+	case s.Status == supportEOL:
+		return "EOL"
+	}
+	return ""
+}
+
+var loadedSupportData *supportData
+
+// getSupportData loads support data from TFENVGO_SUPPORT_DATA URL or path, falling back to the bundled data
+func getSupportData() *supportData {
+	if loadedSupportData != nil {
+		return loadedSupportData
+	}
+
+	data := bundledSupportData
+	if source := getEnv(supportDataEnvKey, ""); source != "" {
+		if override, err := readSource(source, maxSupportDataSize); err != nil {
+			LogWarn("Failed to load support data from %s, using bundled data: %v", source, err)
+		} else {
+			data = []byte(override)
+		}
+	}
+
+	var parsed supportData
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		LogWarn("Failed to parse support data, using bundled data: %v", err)
+		parsed = supportData{}
+		_ = json.Unmarshal(bundledSupportData, &parsed)
+	}
+	loadedSupportData = &parsed
+	return loadedSupportData
+}
+
+// getSupportStatus returns the support status of the version
+func getSupportStatus(version string) supportStatus {
+	status := supportStatus{Status: supportUnknown}
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return status
+	}
+	data := getSupportData()
+
+	// Lines newer than the newest listed one are maintained, lines older than the oldest listed one are EOL
+	minorVersion, _ := semver.NewVersion(fmt.Sprintf("%d.%d.0", v.Major(), v.Minor()))
+	var oldest, newest *semver.Version
+	for _, line := range data.MinorLines {
+		lineVersion, err := semver.NewVersion(line.Minor + ".0")
+		if err != nil {
+			continue
+		}
+		if oldest == nil || lineVersion.LessThan(oldest) {
+			oldest = lineVersion
+		}
+		if newest == nil || lineVersion.GreaterThan(newest) {
+			newest = lineVersion
+		}
+	}
+	switch {
+	case newest != nil && minorVersion.GreaterThan(newest):
+		status.Status = supportMaintained
+	case oldest != nil && minorVersion.LessThan(oldest):
+		status.Status = supportEOL
+	}
+
+	minor := fmt.Sprintf("%d.%d", v.Major(), v.Minor())
+	for _, line := range data.MinorLines {
+		if line.Minor != minor {
+			continue
+		}
+		status.Status = supportMaintained
+		status.EOL = line.EOL
+		if line.Status == supportEOL {
+			status.Status = supportEOL
+		}
+		if line.EOL != "" {
+			if eol, err := time.Parse(time.DateOnly, line.EOL); err == nil && time.Now().After(eol) {
+				status.Status = supportEOL
+			}
+		}
+	}
+
+	for _, bad := range data.KnownBad {
+		// An exact version is a constraint matching only that version
+		if constraint, err := semver.NewConstraint(bad.Version); err == nil && constraint.Check(v) {
+			status.KnownBad = append(status.KnownBad, bad)
+		}
+	}
+	return status
+}
+
+// warnIfUnsupported logs a warning when the version is EOL or flagged
+func warnIfUnsupported(version string) {
+	status := getSupportStatus(version)
+	if !status.isFlagged() {
+		return
+	}
+	if status.Status == supportEOL {
+		if status.EOL != "" {
+			LogWarn("Terraform v%s is end-of-life since %s", version, status.EOL)
+		} else {
+			LogWarn("Terraform v%s is end-of-life", version)
+		}
+	}
+	for _, bad := range status.KnownBad {
+		if bad.Advisory != "" {
+			LogWarn("Terraform v%s is flagged: %s (%s)", version, bad.Reason, bad.Advisory)
+		} else {
+			LogWarn("Terraform v%s is flagged: %s", version, bad.Reason)
+		}
+	}
+}
+
+// formatSupportStatus returns the status with a flagged marker for known bad versions
+func formatSupportStatus(s supportStatus) string {
+	if len(s.KnownBad) > 0 {
+		return s.Status + ", flagged"
+	}
+	return s.Status
+}
+
+// supportAnnotation returns a coloured annotation to append to a version in text output
+func supportAnnotation(version string) string {
+	status := getSupportStatus(version)
+	if !status.isFlagged() {
+		return ""
+	}
+	return " " + Red + "[" + status.label() + "]" + Reset
+}
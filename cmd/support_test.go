@@ -0,0 +1,92 @@
+/*
+Copyright © 2025 Denys Makeienko <denys.makeienko@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGetSupportStatusBundled(t *testing.T) {
+	t.Setenv(supportDataEnvKey, "")
+	loadedSupportData = nil
+	t.Cleanup(func() { loadedSupportData = nil })
+
+	tests := []struct {
+		version string
+		status  string
+		eol     string
+		flagged bool
+		label   string
+	}{
+		{"0.10.8", supportEOL, "", false, "EOL"},
+		{"0.12.31", supportEOL, "2020-12-02", false, "EOL"},
+		{"1.0.7", supportEOL, "2022-05-18", false, "EOL"},
+		{"1.0.8", supportEOL, "2022-05-18", true, "EOL, flagged"},
+		{"1.5.6", supportEOL, "2024-01-17", true, "EOL, flagged"},
+		{"1.5.7", supportEOL, "2024-01-17", false, "EOL"},
+		{"1.13.1", supportMaintained, "", false, ""},
+		{"2.0.0", supportMaintained, "", false, ""},
+		{"invalid", supportUnknown, "", false, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			status := getSupportStatus(tt.version)
+			if status.Status != tt.status || status.EOL != tt.eol {
+				t.Errorf("status = %s, eol %q, want %s, eol %q", status.Status, status.EOL, tt.status, tt.eol)
+			}
+			if flagged := len(status.KnownBad) > 0; flagged != tt.flagged {
+				t.Errorf("flagged = %v, want %v: %+v", flagged, tt.flagged, status.KnownBad)
+			}
+			if status.label() != tt.label {
+				t.Errorf("label = %q, want %q", status.label(), tt.label)
+			}
+		})
+	}
+}
+
+func TestGetSupportStatusKnownBad(t *testing.T) {
+	loadedSupportData = &supportData{
+		KnownBad: []knownBadVersion{
+			{Version: "1.6.0", Reason: "exact"},
+			{Version: ">= 1.6.0, < 1.6.3", Reason: "range"},
+			{Version: "not a version", Reason: "invalid"},
+		},
+	}
+	t.Cleanup(func() { loadedSupportData = nil })
+
+	tests := map[string][]string{
+		"1.5.7": nil,
+		"1.6.0": {"exact", "range"},
+		"1.6.2": {"range"},
+		"1.6.3": nil,
+	}
+	for version, want := range tests {
+		var reasons []string
+		for _, bad := range getSupportStatus(version).KnownBad {
+			reasons = append(reasons, bad.Reason)
+		}
+		if !reflect.DeepEqual(reasons, want) {
+			t.Errorf("%s: known bad = %v, want %v", version, reasons, want)
+		}
+	}
+}
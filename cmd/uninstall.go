@@ -22,73 +22,263 @@ THE SOFTWARE.
 package cmd
 
 import (
+	"bufio"
+	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"regexp"
+	"strings"
+	"time"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/spf13/cobra"
 )
 
-func uninstallTerraform(version string) {
+var (
+	uninstallForce           bool
+	uninstallConstraint      string
+	uninstallAllExceptActive bool
+	uninstallYes             bool
+	uninstallSwitchTo        string
+)
+
+const previousArg = "previous"
+
+func uninstallTerraform(version string) error {
 	if err := os.RemoveAll(filepath.Join(terraformVersionPath, version)); err != nil {
 		LogError("failed to remove version %s: %v", version, err)
-		return
+		return err
 	}
 	LogInfo("Uninstalled Terraform version v%s", version)
+	return nil
+}
+
+// confirm asks a yes/no question, anything but y or yes is treated as no
+func confirm(question string, in io.Reader, out io.Writer) bool {
+	fmt.Fprintf(out, "%s [y/N]: ", question)
+	answer, _ := bufio.NewReader(in).ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
+
+// resolveUninstallVersion resolves a single version argument against installed versions
+func resolveUninstallVersion(version string, versionRegex *regexp.Regexp) (string, error) {
+	allowedVersions := map[string]bool{
+		latestArg: true,
+	}
+	if err := validateArg(version, allowedVersions); err != nil {
+		return "", err
+	}
+	return resolveVersion(version, versionRegex, "local")
+}
+
+// getUninstallMatches returns installed versions selected by arguments, --constraint or --all-except-active
+func getUninstallMatches(args []string) ([]string, error) {
+	if uninstallConstraint != "" || uninstallAllExceptActive {
+		if len(args) > 0 {
+			return nil, fmt.Errorf("versions can't be combined with --constraint or --all-except-active")
+		}
+		var constraints *semver.Constraints
+		if uninstallConstraint != "" {
+			c, err := semver.NewConstraint(uninstallConstraint)
+			if err != nil {
+				return nil, fmt.Errorf("invalid constraint: %w", err)
+			}
+			constraints = c
+		}
+		localVersions, err := getLocalTerraformVersions(PreReleaseVersionsIncluded)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list installed versions: %w", err)
+		}
+		currentVersion, _ := getCurrentTerraformVersion()
+		var matches []string
+		for _, versionStr := range localVersions {
+			if uninstallAllExceptActive && versionStr == currentVersion {
+				continue
+			}
+			if constraints != nil {
+				v, err := semver.NewVersion(versionStr)
+				if err != nil || !constraints.Check(v) {
+					continue
+				}
+			}
+			matches = append(matches, versionStr)
+		}
+		return matches, nil
+	}
+
+	if len(args) == 0 {
+		version := getEnv(terraformVersionEnvKey, latestArg)
+		if version == "" {
+			version = latestArg
+		}
+		args = []string{version}
+	}
+	if len(args) == 2 && args[0] == latestArg {
+		versionRegex, err := regexp.Compile(args[1])
+		if err != nil {
+			FatalError("Invalid regex %q: %v", args[1], err)
+		}
+		version, err := resolveUninstallVersion(args[0], versionRegex)
+		if err != nil {
+			return nil, err
+		}
+		return []string{version}, nil
+	}
+
+	var matches []string
+	for _, arg := range args {
+		version, err := resolveUninstallVersion(arg, nil)
+		if err != nil {
+			return nil, err
+		}
+		if !containsString(matches, version) {
+			matches = append(matches, version)
+		}
+	}
+	return matches, nil
+}
+
+// getSwitchTarget resolves --switch-to value to a version which is not going to be uninstalled.
+// previous is the most recently used installed version and latest is the latest installed version.
+func getSwitchTarget(switchTo string, removed []string) (string, error) {
+	switch switchTo {
+	case previousArg, latestArg:
+		localVersions, err := getLocalTerraformVersions(true)
+		if err != nil {
+			return "", fmt.Errorf("failed to list installed versions: %w", err)
+		}
+		usage, err := readUsage()
+		if err != nil {
+			LogWarn("%v", err)
+		}
+		var target string
+		var targetLastUsed time.Time
+		for _, v := range localVersions {
+			if containsString(removed, v) {
+				continue
+			}
+			if switchTo == latestArg {
+				return v, nil
+			}
+			if lastUsed := getLastUsed(v, usage); target == "" || lastUsed.After(targetLastUsed) {
+				target, targetLastUsed = v, lastUsed
+			}
+		}
+		if target == "" {
+			return "", fmt.Errorf("no other installed version to switch to")
+		}
+		return target, nil
+	}
+
+	if err := validateArg(switchTo, map[string]bool{latestAllowedArg: true, minRequiredArg: true}); err != nil {
+		return "", err
+	}
+	target, err := resolveVersion(switchTo, nil, "remote")
+	if err != nil {
+		return "", err
+	}
+	if containsString(removed, target) {
+		return "", fmt.Errorf("can't switch to v%s, it's going to be uninstalled", target)
+	}
+	return target, nil
 }
 
 // uninstallCmd represents the uninstall command
 var uninstallCmd = &cobra.Command{
-	Use:   "uninstall",
-	Short: "Uninstall a specific Terraform version",
-	Args:  cobra.MaximumNArgs(2),
+	Use:   "uninstall [version...]",
+	Short: "Uninstall Terraform versions",
 	Run: func(cmd *cobra.Command, args []string) {
-		var version string
-		var versionRegex *regexp.Regexp
-		if len(args) == 0 {
-			version = getEnv(terraformVersionEnvKey, latestArg)
-			if version == "" {
-				version = latestArg
-			}
-		} else if len(args) == 1 {
-			version = args[0]
-		} else if len(args) == 2 && args[0] == latestArg {
-			version = args[0]
-			versionRegex = regexp.MustCompile(args[1])
+		matches, err := getUninstallMatches(args)
+		if err != nil {
+			LogError("%v", err)
+			os.Exit(1)
 		}
 
-		allowedVersions := map[string]bool{
-			latestArg: true,
+		currentVersion, _ := getCurrentTerraformVersion()
+		var versions []string
+		for _, version := range matches {
+			if _, err := os.Stat(filepath.Join(terraformVersionPath, version)); err != nil {
+				LogWarn("Terraform v%s is not installed", version)
+				continue
+			}
+			if referencingAliases := getAliasesReferencing(version); len(referencingAliases) > 0 && !uninstallForce {
+				LogError("Terraform v%s is referenced by aliases: %s. Use --force to uninstall anyway", version, strings.Join(referencingAliases, ", "))
+				continue
+			}
+			if version == currentVersion && uninstallSwitchTo == "" {
+				LogError("Terraform v%s is the active version. Use --switch-to <version|previous|latest> to switch to another version first", version)
+				continue
+			}
+			versions = append(versions, version)
 		}
-
-		if validateArg(version, allowedVersions) != nil {
+		if len(versions) == 0 {
+			if len(matches) > 0 {
+				os.Exit(1)
+			}
+			LogInfo("Nothing to uninstall")
 			return
 		}
 
-		if version == latestArg && versionRegex == nil {
-			versions, err := getLocalTerraformVersions(PreReleaseVersionsIncluded)
+		var switchTarget string
+		if containsString(versions, currentVersion) {
+			target, err := getSwitchTarget(uninstallSwitchTo, versions)
 			if err != nil {
-				LogError("failed to get latest version: %v", err)
-				return
+				LogError("Failed to resolve --switch-to version: %v", err)
+				os.Exit(1)
 			}
-			if len(versions) == 0 {
-				LogError("no local versions found")
-				return
+			switchTarget = target
+		}
+
+		sizes := make(map[string]int64, len(versions))
+		for _, version := range versions {
+			sizes[version], _ = getDirSize(filepath.Join(terraformVersionPath, version))
+		}
+
+		if !uninstallYes {
+			fmt.Println("The following versions will be uninstalled:")
+			for _, version := range versions {
+				fmt.Printf("     %s (%s)\n", version, formatBytes(sizes[version]))
 			}
-			version = versions[0]
-		} else if version == latestArg && versionRegex != nil {
-			latestRegexVersion, err := getLatestAllowed("local", versionRegex.String())
-			if err != nil {
-				LogError("Failed to get latest regex version: %v", err)
+			if switchTarget != "" {
+				fmt.Printf("The active version will be switched to %s\n", switchTarget)
+			}
+			if !confirm(fmt.Sprintf("Uninstall %d version(s)?", len(versions)), os.Stdin, os.Stdout) {
+				LogInfo("Aborted")
 				return
 			}
-			version = latestRegexVersion
 		}
-		uninstallTerraform(version)
+
+		// Re-point the active version first, so the symlink never dangles
+		if switchTarget != "" {
+			if err := useVersion(switchTarget); err != nil {
+				LogError("Failed to switch to v%s, the active version is not uninstalled", switchTarget)
+				versions = removeString(versions, currentVersion)
+			}
+		}
+
+		var removed []string
+		var freed int64
+		for _, version := range versions {
+			if uninstallTerraform(version) == nil {
+				removed = append(removed, version)
+				freed += sizes[version]
+			}
+		}
+		LogInfo("Uninstalled %d version(s): %s, freed %s", len(removed), strings.Join(removed, ", "), formatBytes(freed))
+		if len(removed) < len(matches) {
+			os.Exit(1)
+		}
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(uninstallCmd)
 	uninstallCmd.Flags().BoolVarP(&PreReleaseVersionsIncluded, "include-prerelease", "", false, "Include pre-release versions")
+	uninstallCmd.Flags().BoolVarP(&uninstallForce, "force", "f", false, "Uninstall even if the version is referenced by an alias")
+	uninstallCmd.Flags().StringVarP(&uninstallConstraint, "constraint", "c", "", "Uninstall all installed versions satisfying the constraint, e.g. \"< 1.4\"")
+	uninstallCmd.Flags().BoolVarP(&uninstallAllExceptActive, "all-except-active", "", false, "Uninstall all installed versions except the active one")
+	uninstallCmd.Flags().BoolVarP(&uninstallYes, "yes", "y", false, "Don't ask for confirmation")
+	uninstallCmd.Flags().StringVarP(&uninstallSwitchTo, "switch-to", "", "", "Switch to the version, previous or latest installed version before uninstalling the active version")
 }
@@ -0,0 +1,165 @@
+/*
+Copyright © 2025 Denys Makeienko <denys.makeienko@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// versionUsage records when a version was last activated and which project directories resolved it
+type versionUsage struct {
+	LastUsed time.Time `json:"last_used"`
+	Projects []string  `json:"projects,omitempty"`
+}
+
+type usageRecord struct {
+	Versions map[string]*versionUsage `json:"versions"`
+}
+
+func readUsage() (*usageRecord, error) {
+	usage := &usageRecord{Versions: make(map[string]*versionUsage)}
+	data, err := os.ReadFile(usageFilePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return usage, nil
+		}
+		return usage, fmt.Errorf("failed to read %s: %w", usageFilePath, err)
+	}
+	if err := json.Unmarshal(data, usage); err != nil {
+		return usage, fmt.Errorf("failed to parse %s: %w", usageFilePath, err)
+	}
+	if usage.Versions == nil {
+		usage.Versions = make(map[string]*versionUsage)
+	}
+	return usage, nil
+}
+
+func writeUsage(usage *usageRecord) error {
+	data, err := json.MarshalIndent(usage, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal usage: %w", err)
+	}
+	if err := os.MkdirAll(rootURL, 0o750); err != nil {
+		return fmt.Errorf("failed to create %s: %w", rootURL, err)
+	}
+	if err := os.WriteFile(usageFilePath, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("failed to write %s: %w", usageFilePath, err)
+	}
+	return nil
+}
+
+// recordVersionUsage updates last used time of the version. If projectDir is not empty,
+// the directory is recorded as pinning the version and removed from other versions.
+func recordVersionUsage(version, projectDir string) {
+	usage, err := readUsage()
+	if err != nil {
+		LogWarn("Failed to record usage: %v", err)
+		return
+	}
+
+	versionRecord, ok := usage.Versions[version]
+	if !ok {
+		versionRecord = &versionUsage{}
+		usage.Versions[version] = versionRecord
+	}
+	versionRecord.LastUsed = time.Now().UTC()
+
+	if projectDir != "" {
+		for v, record := range usage.Versions {
+			if v == version {
+				continue
+			}
+			record.Projects = removeString(record.Projects, projectDir)
+		}
+		if !containsString(versionRecord.Projects, projectDir) {
+			versionRecord.Projects = append(versionRecord.Projects, projectDir)
+			sort.Strings(versionRecord.Projects)
+		}
+	}
+
+	if err := writeUsage(usage); err != nil {
+		LogWarn("Failed to record usage: %v", err)
+	}
+}
+
+// recordProjectUsage records the current directory as pinning the version
+func recordProjectUsage(version string) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		LogWarn("Failed to record usage: %v", err)
+		return
+	}
+	recordVersionUsage(version, cwd)
+}
+
+// getLastUsed returns the recorded last used time of the version, zero if it was never used.
+// Access time of the binary is not used as tfenvgo itself reads binaries in verify, doctor and repair.
+func getLastUsed(version string, usage *usageRecord) time.Time {
+	if record, ok := usage.Versions[version]; ok {
+		return record.LastUsed
+	}
+	return time.Time{}
+}
+
+// getDirSize returns total size of regular files in the directory
+func getDirSize(path string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.Type().IsRegular() {
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, err
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func removeString(values []string, value string) []string {
+	result := values[:0]
+	for _, v := range values {
+		if v != value {
+			result = append(result, v)
+		}
+	}
+	return result
+}
@@ -29,22 +29,28 @@ import (
 	"github.com/spf13/cobra"
 )
 
-func useVersion(version string) {
+func useVersion(version string) error {
 	err := initConfig()
 	if err != nil {
 		LogError("Failed to create config: %v", err)
-		return
+		return err
 	}
 
 	terraformSelectedPath := filepath.Join(terraformVersionPath, version, "terraform")
 	if _, err := os.Stat(terraformSelectedPath); err != nil {
 		if os.IsNotExist(err) {
 			LogWarn("Terraform v%s is not installed", version)
+			if !getSettingBool("auto_install") {
+				LogError("Automatic installation is disabled by auto_install setting, run tfenvgo install %s", version)
+				return err
+			}
 			LogInfo("Trying to install terraform v%s", version)
-			installTerraform(version)
+			if err := installTerraform(version); err != nil {
+				return err
+			}
 		} else {
 			LogError("Error checking terraform path: %v", err)
-			return
+			return err
 		}
 	}
 
@@ -52,24 +58,64 @@ func useVersion(version string) {
 	if _, err := os.Lstat(currentTerraformVersionPath); err == nil {
 		if err := os.Remove(currentTerraformVersionPath); err != nil {
 			LogError("Failed to remove existing symlink: %v", err)
-			return
+			return err
 		}
 	}
 
 	// Create new symlink
 	if err := os.Symlink(terraformSelectedPath, currentTerraformVersionPath); err != nil {
 		LogError("Failed to create symlink: %v", err)
-		return
+		return err
 	}
 
 	// Set executable permissions on the symlink target (not the symlink itself)
 	// Intentionally allow executable bit for the terraform binary. Permissions are set to 0755.
 	if err := os.Chmod(terraformSelectedPath, 0o755); err != nil {
 		LogError("Failed to update permissions: %v", err)
-		return
+		return err
 	}
 
 	LogInfo("Changed current terraform version to v%s", version)
+	warnIfUnsupported(version)
+	recordVersionUsage(version, "")
+	return nil
+}
+
+var useAuto bool
+
+// useProjectVersion switches to the version requested for the current directory only if it differs from the active one.
+// It is silent when nothing is requested, so it is safe to run from shell hooks on every directory change.
+func useProjectVersion() {
+	version, source, err := getProjectVersion()
+	if err != nil {
+		return
+	}
+	isActive := func(version string) bool {
+		currentVersion, err := getCurrentTerraformVersion()
+		return err == nil && currentVersion == version
+	}
+	// The project is recorded even if its version is already active
+	if isActive(version) {
+		if source == sourceFile {
+			recordProjectUsage(version)
+		}
+		return
+	}
+
+	if validateArg(version, map[string]bool{}) != nil {
+		return
+	}
+	version, err = resolveVersion(version, nil, "remote")
+	if err != nil {
+		LogError("%v", err)
+		return
+	}
+	if !isActive(version) && useVersion(version) != nil {
+		return
+	}
+	if source == sourceFile {
+		recordProjectUsage(version)
+	}
 }
 
 var useCmd = &cobra.Command{
@@ -77,9 +123,25 @@ var useCmd = &cobra.Command{
 	Short: "Change the current Terraform version",
 	Args:  cobra.MaximumNArgs(2),
 	Run: func(cmd *cobra.Command, args []string) {
+		if useAuto {
+			useProjectVersion()
+			return
+		}
+
+		if interactive {
+			version, err := pickVersionInteractively()
+			if err != nil {
+				LogError("%v", err)
+				return
+			}
+			_ = useVersion(version)
+			return
+		}
+
 		var version string
 		var versionRegex *regexp.Regexp
 		versionFromFile, _ := readVersionFromFile()
+		fromFile := len(args) == 0 && getEnv(terraformVersionEnvKey, "") == "" && versionFromFile != ""
 		if len(args) == 0 {
 			version = getEnv(terraformVersionEnvKey, versionFromFile)
 			if version == "" {
@@ -102,45 +164,20 @@ var useCmd = &cobra.Command{
 			return
 		}
 
-		switch {
-		case (version == latestArg && versionRegex == nil):
-			versions, err := getRemoteTerraformVersions(PreReleaseVersionsIncluded)
-			if err != nil {
-				LogError("failed to use check installed version: %v", err)
-				return
-			}
-			if len(versions) == 0 {
-				LogError("no remote versions available")
-				return
-			}
-			version = versions[0]
-		case (version == minRequiredArg):
-			minRequiredVersion, err := getMinRequired("remote")
-			if err != nil {
-				LogError("Failed to use minimum required version: %v", err)
-				return
-			}
-			version = minRequiredVersion
-		case (version == latestAllowedArg):
-			latestAllowedVersion, err := getLatestAllowed("remote", "")
-			if err != nil {
-				LogError("Failed to use latest allowed version: %v", err)
-				return
-			}
-			version = latestAllowedVersion
-		case (version == latestArg && versionRegex != nil):
-			latestRegexVersion, err := getLatestAllowed("remote", versionRegex.String())
-			if err != nil {
-				LogError("Failed to get latest regex version: %v", err)
-				return
-			}
-			version = latestRegexVersion
+		version, err := resolveVersion(version, versionRegex, "remote")
+		if err != nil {
+			LogError("%v", err)
+			return
+		}
+		if useVersion(version) == nil && fromFile {
+			recordProjectUsage(version)
 		}
-		useVersion(version)
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(useCmd)
 	useCmd.Flags().BoolVarP(&PreReleaseVersionsIncluded, "include-prerelease", "", false, "Include pre-release versions")
+	useCmd.Flags().BoolVarP(&interactive, "interactive", "i", false, "Pick the version interactively")
+	useCmd.Flags().BoolVarP(&useAuto, "auto", "", false, "Switch only if the version requested for the current directory differs from the active one")
 }
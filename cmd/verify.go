@@ -0,0 +1,272 @@
+/*
+Copyright © 2025 Denys Makeienko <denys.makeienko@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"archive/zip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/spf13/cobra"
+)
+
+// Verification statuses
+const (
+	verifyOK         = "ok"
+	verifyUnverified = "unverified"
+	verifyCorrupted  = "corrupted"
+	verifyIncomplete = "incomplete"
+)
+
+// Checksum sources
+const (
+	checksumFromManifest = "manifest"
+	checksumFromUpstream = "upstream"
+	checksumNone         = "none"
+)
+
+var (
+	verifyAll      bool
+	verifyUpstream bool
+)
+
+// verifyResult is the result of verifying an installed version
+type verifyResult struct {
+	Version  string `json:"version" yaml:"version"`
+	Status   string `json:"status" yaml:"status"`
+	Checksum string `json:"checksum" yaml:"checksum"`
+	Message  string `json:"message,omitempty" yaml:"message,omitempty"`
+}
+
+// broken reports whether the version has to be reinstalled
+func (r verifyResult) broken() bool {
+	return r.Status == verifyCorrupted || r.Status == verifyIncomplete
+}
+
+// getUpstreamBinarySHA256 downloads the release archive and returns the checksum of the binary inside
+func getUpstreamBinarySHA256(version, osType, arch string) (string, error) {
+	archiveName := getArchiveName(version, osType, arch)
+	archivePath, err := downloadFile(getMirrorURL()+"/"+version+"/"+archiveName, "tfenvgo-*.zip")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(archivePath)
+
+	if getSettingString("verification") == verificationChecksum {
+		if err := verifyArchiveChecksum(archivePath, version, archiveName); err != nil {
+			return "", err
+		}
+	}
+
+	archive, err := zip.OpenReader(archivePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to open archive: %w", err)
+	}
+	defer archive.Close()
+
+	for _, f := range archive.File {
+		if name := path.Base(f.Name); name != "terraform" && name != "terraform.exe" {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return "", err
+		}
+		defer rc.Close()
+		hash := sha256.New()
+		if _, err := io.Copy(hash, rc); err != nil {
+			return "", err
+		}
+		return hex.EncodeToString(hash.Sum(nil)), nil
+	}
+	return "", fmt.Errorf("%s contains no terraform binary", archiveName)
+}
+
+// verifyVersion checks the binary checksum against the manifest or the upstream release and runs it
+func verifyVersion(version string, upstream bool) verifyResult {
+	result := verifyResult{Version: version, Checksum: checksumNone}
+	binary := filepath.Join(terraformVersionPath, version, "terraform")
+	stat, err := os.Stat(binary)
+	if err != nil {
+		result.Status = verifyIncomplete
+		result.Message = fmt.Sprintf("%s is missing", binary)
+		return result
+	}
+	if stat.Size() == 0 {
+		result.Status = verifyIncomplete
+		result.Message = fmt.Sprintf("%s is empty", binary)
+		return result
+	}
+
+	var expected string
+	manifest, manifestErr := readManifest(version)
+	if manifestErr == nil && !upstream {
+		if manifest.Size != stat.Size() {
+			result.Status = verifyCorrupted
+			result.Checksum = checksumFromManifest
+			result.Message = fmt.Sprintf("size mismatch: expected %d bytes, got %d", manifest.Size, stat.Size())
+			return result
+		}
+		expected, result.Checksum = manifest.BinarySHA256, checksumFromManifest
+	} else {
+		osType, arch, err := binaryPlatform(binary)
+		if err != nil {
+			osType, arch = getPlatform()
+		}
+		sha, err := getUpstreamBinarySHA256(version, osType, arch)
+		if err != nil {
+			LogWarn("Failed to get upstream checksum of v%s: %v", version, err)
+		} else {
+			expected, result.Checksum = sha, checksumFromUpstream
+		}
+	}
+
+	if expected != "" {
+		actual, err := fileSHA256(binary)
+		if err != nil {
+			result.Status = verifyCorrupted
+			result.Message = fmt.Sprintf("failed to read binary: %v", err)
+			return result
+		}
+		if !strings.EqualFold(actual, expected) {
+			result.Status = verifyCorrupted
+			result.Message = fmt.Sprintf("checksum mismatch: expected %s, got %s", expected, actual)
+			return result
+		}
+	}
+
+	// Binaries built for another platform can't be run, the checksum is all that can be checked
+	if osType, arch, err := binaryPlatform(binary); err == nil && (osType != runtime.GOOS || arch != runtime.GOARCH) {
+		result.Message = fmt.Sprintf("smoke test skipped, binary is built for %s/%s", osType, arch)
+	} else {
+		reported, err := getBinaryVersion(binary)
+		if err != nil {
+			result.Status = verifyCorrupted
+			result.Message = fmt.Sprintf("smoke test failed: %v", err)
+			return result
+		}
+		reportedVersion, reportedErr := semver.NewVersion(reported)
+		expectedVersion, expectedErr := semver.NewVersion(version)
+		if reportedErr != nil || expectedErr != nil || !reportedVersion.Equal(expectedVersion) {
+			result.Status = verifyCorrupted
+			result.Message = fmt.Sprintf("smoke test failed: binary reports version %s", reported)
+			return result
+		}
+	}
+
+	if expected == "" {
+		result.Status = verifyUnverified
+		result.Message = strings.TrimPrefix(result.Message+", no checksum available", ", ")
+		return result
+	}
+	result.Status = verifyOK
+	return result
+}
+
+// getVerifyTargets returns installed versions selected by the argument or all installed versions
+func getVerifyTargets(args []string, all bool) ([]string, error) {
+	if all {
+		if len(args) > 0 {
+			return nil, fmt.Errorf("version can't be combined with --all")
+		}
+		versions, err := getLocalTerraformVersions(true)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list installed versions: %w", err)
+		}
+		return versions, nil
+	}
+	if len(args) == 0 {
+		return nil, fmt.Errorf("specify a version or --all")
+	}
+	version, err := resolveUninstallVersion(args[0], nil)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(filepath.Join(terraformVersionPath, version)); err != nil {
+		return nil, fmt.Errorf("version %s is not installed", version)
+	}
+	return []string{version}, nil
+}
+
+func printVerifyResults(results []verifyResult) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tSTATUS\tCHECKSUM\tMESSAGE")
+	for _, r := range results {
+		message := r.Message
+		if message == "" {
+			message = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", r.Version, r.Status, r.Checksum, message)
+	}
+	_ = w.Flush()
+}
+
+// verifyCmd represents the verify command
+var verifyCmd = &cobra.Command{
+	Use:   "verify [version]",
+	Short: "Verify checksums of installed Terraform binaries and run them",
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := setupOutputFormats(outputText, outputJSON, outputYAML); err != nil {
+			FatalError("%v", err)
+		}
+		versions, err := getVerifyTargets(args, verifyAll)
+		if err != nil {
+			FatalError("%v", err)
+		}
+
+		results := make([]verifyResult, 0, len(versions))
+		for _, version := range versions {
+			results = append(results, verifyVersion(version, verifyUpstream))
+		}
+		switch outputFormat {
+		case outputJSON, outputYAML:
+			if err := printStructured(os.Stdout, results); err != nil {
+				FatalError("%v", err)
+			}
+		default:
+			printVerifyResults(results)
+		}
+
+		for _, result := range results {
+			if result.broken() {
+				os.Exit(1)
+			}
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(verifyCmd)
+	verifyCmd.Flags().BoolVarP(&verifyAll, "all", "a", false, "Verify all installed versions")
+	verifyCmd.Flags().BoolVarP(&verifyUpstream, "upstream", "", false, "Compare with the binary from the mirror even if the install manifest has a checksum")
+	verifyCmd.Flags().StringVarP(&outputFormat, "output", "o", outputText, "Output format: text, json or yaml")
+}
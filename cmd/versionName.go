@@ -23,6 +23,7 @@ package cmd
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
 )
@@ -32,15 +33,33 @@ var versionNameCmd = &cobra.Command{
 	Aliases: []string{"version"},
 	Short:   "Display the current Terraform version set by tfenvgo",
 	Run: func(cmd *cobra.Command, args []string) {
+		if err := setupOutput(); err != nil {
+			FatalError("%v", err)
+		}
+
 		currentVersion, err := getCurrentTerraformVersion()
 		if err != nil {
+			if isMachineOutput() {
+				FatalError("Failed to get current terraform version: %v", err)
+			}
 			fmt.Println(Red + "Failed to get current terraform version: " + err.Error() + Reset)
 			return
 		}
-		fmt.Println(Green + "Current Terraform version: " + currentVersion + Reset)
+
+		switch outputFormat {
+		case outputPlain:
+			fmt.Println(currentVersion)
+		case outputJSON, outputYAML:
+			if err := printStructured(os.Stdout, newVersionInfo(currentVersion, sourceActive, currentVersion)); err != nil {
+				FatalError("%v", err)
+			}
+		default:
+			fmt.Println(Green + "Current Terraform version: " + currentVersion + Reset)
+		}
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(versionNameCmd)
+	versionNameCmd.Flags().StringVarP(&outputFormat, "output", "o", outputText, "Output format: text, plain, json or yaml")
 }
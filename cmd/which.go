@@ -0,0 +1,114 @@
+/*
+Copyright © 2025 Denys Makeienko <denys.makeienko@gmail.com>
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/spf13/cobra"
+)
+
+var whichJSONOutput bool
+
+// whichCmd represents the which command
+var whichCmd = &cobra.Command{
+	Use:   "which [version]",
+	Short: "Display the path to the Terraform binary that will be executed",
+	Args:  cobra.MaximumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		// Keep stdout for the path so the output can be used in scripts
+		SetLogOutput(os.Stderr)
+
+		if whichJSONOutput {
+			outputFormat = outputJSON
+		}
+		if err := setupOutput(); err != nil {
+			FatalError("%v", err)
+		}
+
+		var version, source string
+		var versionRegex *regexp.Regexp
+		if len(args) == 0 {
+			requestedVersion, requestedSource, err := getRequestedVersion()
+			if err != nil {
+				FatalError("Failed to detect terraform version: %v", err)
+			}
+			version, source = requestedVersion, requestedSource
+		} else {
+			version, source = args[0], sourceArgument
+			if len(args) == 2 && args[0] == latestArg {
+				regex, err := regexp.Compile(args[1])
+				if err != nil {
+					FatalError("Invalid regex %q: %v", args[1], err)
+				}
+				versionRegex = regex
+			}
+		}
+
+		allowedVersions := map[string]bool{
+			latestArg:        true,
+			latestAllowedArg: true,
+			minRequiredArg:   true,
+		}
+
+		if validateArg(version, allowedVersions) != nil {
+			os.Exit(1)
+		}
+
+		version, err := resolveVersion(version, versionRegex, "local")
+		if err != nil {
+			FatalError("%v", err)
+		}
+
+		currentTerraformVersion, _ := getCurrentTerraformVersion()
+		result := newVersionInfo(version, source, currentTerraformVersion)
+		// Report the path even if the version is not installed yet
+		result.Path = filepath.Join(terraformVersionPath, version, "terraform")
+
+		switch outputFormat {
+		case outputJSON, outputYAML:
+			if err := printStructured(os.Stdout, result); err != nil {
+				FatalError("%v", err)
+			}
+		default:
+			if result.Installed {
+				fmt.Println(result.Path)
+			}
+		}
+
+		if !result.Installed {
+			LogError("Terraform v%s is not installed", version)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(whichCmd)
+	whichCmd.Flags().BoolVarP(&whichJSONOutput, "json", "", false, "Output in JSON format, same as --output json")
+	_ = whichCmd.Flags().MarkDeprecated("json", "use --output json instead")
+	whichCmd.Flags().StringVarP(&outputFormat, "output", "o", outputText, "Output format: text, plain, json or yaml")
+	whichCmd.Flags().BoolVarP(&PreReleaseVersionsIncluded, "include-prerelease", "", false, "Include pre-release versions")
+}